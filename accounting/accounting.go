@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/algorand/go-algorand-sdk/crypto"
 	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	atypes "github.com/algorand/go-algorand-sdk/types"
 
@@ -72,9 +73,17 @@ func (accounting *AccountingState) commitRound() error {
 	}
 	accounting.AlgoUpdates = nil
 	accounting.AccountTypes = nil
+	accounting.LsigPrograms = nil
 	accounting.AccountDataUpdates = nil
+	accounting.ActiveAccounts = nil
+	accounting.AccountCloses = nil
+	accounting.RewardsUpdates = nil
+	accounting.AuthUpdates = nil
+	accounting.KeyregUpdates = nil
 	accounting.AssetUpdates = nil
 	accounting.AcfgUpdates = nil
+	accounting.AssetConfigUpdates = nil
+	accounting.Stats = idb.RoundStats{}
 	accounting.TxnAssetUpdates = nil
 	accounting.FreezeUpdates = nil
 	accounting.AssetCloses = nil
@@ -93,15 +102,6 @@ func addrIsZero(a types.Address) bool {
 	return bytes.Equal(a[:], zeroAddr[:])
 }
 
-func bytesAreZero(b []byte) bool {
-	for _, x := range b {
-		if x != 0 {
-			return false
-		}
-	}
-	return true
-}
-
 func (accounting *AccountingState) updateAlgo(addr types.Address, d int64) {
 	if accounting.AlgoUpdates == nil {
 		accounting.AlgoUpdates = make(map[[32]byte]int64)
@@ -109,6 +109,28 @@ func (accounting *AccountingState) updateAlgo(addr types.Address, d int64) {
 	accounting.AlgoUpdates[addr] = accounting.AlgoUpdates[addr] + d
 }
 
+func (accounting *AccountingState) markActive(addr types.Address) {
+	if accounting.ActiveAccounts == nil {
+		accounting.ActiveAccounts = make(map[[32]byte]bool)
+	}
+	accounting.ActiveAccounts[addr] = true
+}
+
+func (accounting *AccountingState) closeAccount(addr types.Address) {
+	if accounting.AccountCloses == nil {
+		accounting.AccountCloses = make(map[[32]byte]bool)
+	}
+	accounting.AccountCloses[addr] = true
+}
+
+// updateRewards credits rewards to addr, which must also be passed to updateAlgo.
+func (accounting *AccountingState) updateRewards(addr types.Address, rewards uint64) {
+	if accounting.RewardsUpdates == nil {
+		accounting.RewardsUpdates = make(map[[32]byte]uint64)
+	}
+	accounting.RewardsUpdates[addr] += rewards
+}
+
 func (accounting *AccountingState) updateAccountType(addr types.Address, ktype string) {
 	if accounting.AccountTypes == nil {
 		accounting.AccountTypes = make(map[[32]byte]string)
@@ -116,6 +138,13 @@ func (accounting *AccountingState) updateAccountType(addr types.Address, ktype s
 	accounting.AccountTypes[addr] = ktype
 }
 
+func (accounting *AccountingState) updateLsigProgram(addr types.Address, program []byte) {
+	if accounting.LsigPrograms == nil {
+		accounting.LsigPrograms = make(map[[32]byte][]byte)
+	}
+	accounting.LsigPrograms[addr] = program
+}
+
 func (accounting *AccountingState) updateAccountData(addr types.Address, key string, field interface{}) {
 	if accounting.AccountDataUpdates == nil {
 		accounting.AccountDataUpdates = make(map[[32]byte]map[string]interface{})
@@ -242,57 +271,64 @@ func (accounting *AccountingState) AddTransaction(round uint64, intra int, txnby
 	}
 	if isNew {
 		accounting.updateAccountType(stxn.Txn.Sender, ktype)
+		if ktype == "lsig" {
+			accounting.updateLsigProgram(stxn.Txn.Sender, stxn.Lsig.Logic)
+		}
 	}
 
+	accounting.markActive(stxn.Txn.Sender)
+	accounting.Stats.Add(&stxn)
 	accounting.updateAlgo(stxn.Txn.Sender, -int64(stxn.Txn.Fee))
 	accounting.updateAlgo(accounting.feeAddr, int64(stxn.Txn.Fee))
 
 	if stxn.SenderRewards != 0 {
 		accounting.updateAlgo(stxn.Txn.Sender, int64(stxn.SenderRewards))
 		accounting.updateAlgo(accounting.rewardAddr, -int64(stxn.SenderRewards))
+		accounting.updateRewards(stxn.Txn.Sender, uint64(stxn.SenderRewards))
 	}
 
 	if !stxn.Txn.RekeyTo.IsZero() {
 		accounting.updateAccountData(stxn.Txn.Sender, "spend", stxn.Txn.RekeyTo)
+		accounting.AuthUpdates = append(accounting.AuthUpdates, idb.AuthUpdate{
+			Addr:     stxn.Txn.Sender,
+			AuthAddr: stxn.Txn.RekeyTo,
+			Round:    round,
+			Intra:    intra,
+			Txid:     crypto.TransactionIDString(stxn.Txn),
+		})
 	}
 
 	if stxn.Txn.Type == "pay" {
+		// algod moves the rewards base of the receiver and close-to
+		// even when no algos move, so always record an update for them
 		amount := int64(stxn.Txn.Amount)
-		if amount != 0 {
-			accounting.updateAlgo(stxn.Txn.Sender, -amount)
-			accounting.updateAlgo(stxn.Txn.Receiver, amount)
-		}
-		if stxn.ClosingAmount != 0 {
+		accounting.updateAlgo(stxn.Txn.Sender, -amount)
+		accounting.updateAlgo(stxn.Txn.Receiver, amount)
+		if !stxn.Txn.CloseRemainderTo.IsZero() {
 			accounting.updateAlgo(stxn.Txn.Sender, -int64(stxn.ClosingAmount))
 			accounting.updateAlgo(stxn.Txn.CloseRemainderTo, int64(stxn.ClosingAmount))
+			accounting.closeAccount(stxn.Txn.Sender)
 		}
 		if stxn.ReceiverRewards != 0 {
 			accounting.updateAlgo(stxn.Txn.Receiver, int64(stxn.ReceiverRewards))
 			accounting.updateAlgo(accounting.rewardAddr, -int64(stxn.ReceiverRewards))
+			accounting.updateRewards(stxn.Txn.Receiver, uint64(stxn.ReceiverRewards))
 		}
 		if stxn.CloseRewards != 0 {
 			accounting.updateAlgo(stxn.Txn.CloseRemainderTo, int64(stxn.CloseRewards))
 			accounting.updateAlgo(accounting.rewardAddr, -int64(stxn.CloseRewards))
+			accounting.updateRewards(stxn.Txn.CloseRemainderTo, uint64(stxn.CloseRewards))
 		}
 	} else if stxn.Txn.Type == "keyreg" {
 		// see https://github.com/algorand/go-algorand/blob/master/data/transactions/keyreg.go
 		accounting.updateAccountData(stxn.Txn.Sender, "vote", stxn.Txn.VotePK)
 		accounting.updateAccountData(stxn.Txn.Sender, "sel", stxn.Txn.SelectionPK)
-		if bytesAreZero(stxn.Txn.VotePK[:]) || bytesAreZero(stxn.Txn.SelectionPK[:]) {
-			if stxn.Txn.Nonparticipation {
-				accounting.updateAccountData(stxn.Txn.Sender, "onl", 2) // NotParticipating
-			} else {
-				accounting.updateAccountData(stxn.Txn.Sender, "onl", 0) // Offline
-			}
-			accounting.updateAccountData(stxn.Txn.Sender, "voteFst", 0)
-			accounting.updateAccountData(stxn.Txn.Sender, "voteLst", 0)
-			accounting.updateAccountData(stxn.Txn.Sender, "voteKD", 0)
-		} else {
-			accounting.updateAccountData(stxn.Txn.Sender, "onl", 1) // Online
-			accounting.updateAccountData(stxn.Txn.Sender, "voteFst", uint64(stxn.Txn.VoteFirst))
-			accounting.updateAccountData(stxn.Txn.Sender, "voteLst", uint64(stxn.Txn.VoteLast))
-			accounting.updateAccountData(stxn.Txn.Sender, "voteKD", stxn.Txn.VoteKeyDilution)
-		}
+		kr := idb.NewKeyregUpdate(&stxn, round, intra)
+		accounting.updateAccountData(stxn.Txn.Sender, "onl", kr.Status)
+		accounting.updateAccountData(stxn.Txn.Sender, "voteFst", kr.VoteFirst)
+		accounting.updateAccountData(stxn.Txn.Sender, "voteLst", kr.VoteLast)
+		accounting.updateAccountData(stxn.Txn.Sender, "voteKD", kr.VoteKeyDilution)
+		accounting.KeyregUpdates = append(accounting.KeyregUpdates, kr)
 	} else if stxn.Txn.Type == "acfg" {
 		assetId := uint64(stxn.Txn.ConfigAsset)
 		if assetId == 0 {
@@ -304,15 +340,28 @@ func (accounting *AccountingState) AddTransaction(round uint64, intra int, txnby
 			assetId = txnCounter + uint64(intra) + 1
 			accounting.updateTxnAsset(round, intra, assetId)
 		}
+		params := stxn.Txn.AssetParams
+		if stxn.Txn.ConfigAsset != 0 {
+			// reconfigure or destroy, the rest of the params are ignored
+			params = idb.RoleParams(params)
+		}
+		accounting.AssetConfigUpdates = append(accounting.AssetConfigUpdates, idb.AssetConfigUpdate{
+			AssetId: assetId,
+			Sender:  stxn.Txn.Sender,
+			Params:  params,
+			Round:   round,
+			Intra:   intra,
+			Txid:    crypto.TransactionIDString(stxn.Txn),
+		})
 		if stxn.Txn.AssetParams.IsZero() {
 			accounting.destroyAsset(assetId)
 		} else {
-			accounting.AcfgUpdates = append(accounting.AcfgUpdates, idb.AcfgUpdate{AssetId: assetId, Creator: stxn.Txn.Sender, Params: stxn.Txn.AssetParams})
-			accounting.defaultFrozen[assetId] = stxn.Txn.AssetParams.DefaultFrozen
+			accounting.AcfgUpdates = append(accounting.AcfgUpdates, idb.AcfgUpdate{AssetId: assetId, Creator: stxn.Txn.Sender, Params: params})
 			if stxn.Txn.ConfigAsset == 0 {
+				accounting.defaultFrozen[assetId] = params.DefaultFrozen
 				// initial creation, give all initial value to creator
-				if stxn.Txn.AssetParams.Total != 0 {
-					accounting.updateAsset(stxn.Txn.Sender, assetId, stxn.Txn.AssetParams.Total, 0)
+				if params.Total != 0 {
+					accounting.updateAsset(stxn.Txn.Sender, assetId, params.Total, 0)
 				}
 			}
 		}
@@ -0,0 +1,84 @@
+// +build !nopostgres
+
+package accounting
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/encoding/json"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	atypes "github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/idb"
+	"github.com/algorand/indexer/types"
+)
+
+// setupTestPostgres opens the database named by INDEXER_TEST_POSTGRES
+// after emptying it, or skips the test when it is not set. It also
+// returns a plain connection for checking the tables.
+func setupTestPostgres(t *testing.T) (*idb.PostgresIndexerDb, *sql.DB) {
+	connection := os.Getenv("INDEXER_TEST_POSTGRES")
+	if connection == "" {
+		t.Skip("INDEXER_TEST_POSTGRES not set to a database the tests may empty")
+	}
+	raw, err := sql.Open("postgres", connection)
+	require.NoError(t, err)
+	_, err = raw.Exec(`DROP SCHEMA public CASCADE; CREATE SCHEMA public`)
+	require.NoError(t, err)
+	db, err := idb.OpenPostgres(connection)
+	require.NoError(t, err)
+	return db, raw
+}
+
+func TestZeroPayToNewAddress(t *testing.T) {
+	db, raw := setupTestPostgres(t)
+	defer raw.Close()
+
+	var sender, receiver, funded types.Address
+	sender[0] = 1
+	receiver[0] = 2
+	funded[0] = 3
+	var block types.Block
+	block.Round = 5
+	block.RewardsLevel = 7
+	block.FeeSink[0] = 4
+	block.RewardsPool[0] = 5
+	_, err := raw.Exec(`INSERT INTO block_header (round, realtime, rewardslevel, header) VALUES (5, $1, 7, $2)`, time.Unix(1600000000, 0).UTC(), string(json.Encode(block)))
+	require.NoError(t, err)
+	_, err = raw.Exec(`INSERT INTO account (addr, microalgos, rewardsbase, created_at) VALUES ($1, 1000000, 0, 1), ($2, 1000000, 0, 1)`, sender[:], funded[:])
+	require.NoError(t, err)
+
+	pay := func(to types.Address) []byte {
+		var stxn types.SignedTxnWithAD
+		stxn.Txn.Type = atypes.PaymentTx
+		stxn.Txn.Sender = sender
+		stxn.Txn.Fee = 1000
+		stxn.Txn.Receiver = to
+		return msgpack.Encode(stxn)
+	}
+	accounting := New(db)
+	require.NoError(t, accounting.AddTransaction(5, 0, pay(receiver)))
+	require.NoError(t, accounting.AddTransaction(5, 1, pay(funded)))
+	require.NoError(t, accounting.Close())
+
+	var count int
+	err = raw.QueryRow(`SELECT count(*) FROM account WHERE addr = $1`, receiver[:]).Scan(&count)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// an existing receiver still has its rewards base moved
+	var rewardsbase uint64
+	err = raw.QueryRow(`SELECT rewardsbase FROM account WHERE addr = $1`, funded[:]).Scan(&rewardsbase)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), rewardsbase)
+
+	var newAccounts uint64
+	err = raw.QueryRow(`SELECT new_accounts FROM stats_round WHERE round = 5`).Scan(&newAccounts)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), newAccounts)
+}
@@ -2,6 +2,7 @@ package accounting
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
 	atypes "github.com/algorand/go-algorand-sdk/types"
@@ -35,33 +36,75 @@ func assetUpdate(account *models.Account, assetid uint64, add, sub uint64) {
 	*account.Assets = assets
 }
 
+// participationAtRound finds the status and participation keys of addr
+// at round from its keyreg history. It returns ok=false when nothing
+// changed after round, so the current values still hold.
+func participationAtRound(addr atypes.Address, round uint64, db idb.IndexerDb) (status int, part *models.AccountParticipation, ok bool, err error) {
+	// an account is Offline until its first keyreg
+	for row := range db.ParticipationHistory(context.Background(), addr[:]) {
+		if row.Error != nil {
+			err = row.Error
+			return
+		}
+		if row.Round > round {
+			ok = true
+			continue
+		}
+		status = row.Status
+		part = nil
+		if row.VoteKey != nil {
+			part = &models.AccountParticipation{
+				VoteParticipationKey:      row.VoteKey,
+				SelectionParticipationKey: row.SelectionKey,
+				VoteFirstValid:            row.VoteFirst,
+				VoteLastValid:             row.VoteLast,
+				VoteKeyDilution:           row.VoteKeyDilution,
+			}
+		}
+	}
+	return
+}
+
+func statusIndex(status string) int {
+	for i, s := range idb.StatusStrings {
+		if s == status {
+			return i
+		}
+	}
+	return 0
+}
+
 func AccountAtRound(account models.Account, round uint64, db idb.IndexerDb) (acct models.Account, err error) {
 	acct = account
 	addr, err := atypes.DecodeAddress(account.Address)
 	if err != nil {
 		return
 	}
+	// rewards credited to the account, without pending rewards
+	rewarded := acct.Rewards - acct.PendingRewards
+	// whether an undone txn moved the account's rewards base
+	touched := false
 	tf := idb.TransactionFilter{
 		Address:  addr[:],
 		MinRound: round + 1,
 		MaxRound: account.Round,
 	}
 	txns := db.Transactions(context.Background(), tf)
-	txcount := 0
 	for txnrow := range txns {
 		if txnrow.Error != nil {
 			err = txnrow.Error
 			return
 		}
-		txcount++
 		var stxn types.SignedTxnWithAD
 		err = msgpack.Decode(txnrow.TxnBytes, &stxn)
 		if err != nil {
 			return
 		}
 		if addr == stxn.Txn.Sender {
+			touched = true
 			acct.AmountWithoutPendingRewards += uint64(stxn.Txn.Fee)
 			acct.AmountWithoutPendingRewards -= uint64(stxn.SenderRewards)
+			rewarded -= uint64(stxn.SenderRewards)
 		}
 		switch stxn.Txn.Type {
 		case atypes.PaymentTx:
@@ -69,20 +112,24 @@ func AccountAtRound(account models.Account, round uint64, db idb.IndexerDb) (acc
 				acct.AmountWithoutPendingRewards += uint64(stxn.Txn.Amount)
 			}
 			if addr == stxn.Txn.Receiver {
+				touched = true
 				acct.AmountWithoutPendingRewards -= uint64(stxn.Txn.Amount)
 				acct.AmountWithoutPendingRewards -= uint64(stxn.ReceiverRewards)
+				rewarded -= uint64(stxn.ReceiverRewards)
 			}
 			if addr == stxn.Txn.CloseRemainderTo {
 				// unwind receiving a close-to
+				touched = true
 				acct.AmountWithoutPendingRewards -= uint64(stxn.ClosingAmount)
 				acct.AmountWithoutPendingRewards -= uint64(stxn.CloseRewards)
+				rewarded -= uint64(stxn.CloseRewards)
 			} else if !stxn.Txn.CloseRemainderTo.IsZero() {
 				// unwind sending a close-to
 				acct.AmountWithoutPendingRewards += uint64(stxn.ClosingAmount)
 				acct.AmountWithoutPendingRewards += uint64(stxn.CloseRewards)
 			}
 		case atypes.KeyRegistrationTx:
-			// TODO: keyreg does not rewind. workaround: query for txns on an account with typeenum=2 to find previous values it was set to.
+			// rewound from the keyreg history below
 		case atypes.AssetConfigTx:
 			if stxn.Txn.ConfigAsset == 0 {
 				// create asset, unwind the application of the value
@@ -104,59 +151,253 @@ func AccountAtRound(account models.Account, round uint64, db idb.IndexerDb) (acc
 		}
 	}
 
-	if txcount > 0 {
-		// If we found any txns above, we need to find one
-		// more so we can know what the previous RewardsBase
-		// of the account was so we can get the accurate
-		// pending rewards at the target round.
-		//
-		// (If there weren't any txns above, the recorded
-		// RewardsBase is current from whatever previous txn
-		// happened to this account.)
+	status, part, changed, err := participationAtRound(addr, round, db)
+	if err != nil {
+		return
+	}
+	if changed {
+		acct.Status = idb.StatusStrings[status]
+		acct.Participation = part
+	} else {
+		status = statusIndex(acct.Status)
+	}
 
+	var rewardsBase uint64
+	if acct.RewardBase != nil {
+		rewardsBase = *acct.RewardBase
+	}
+	if touched {
+		// If we undid any txns that moved the rewards base
+		// above, the rewards base at the target round is the
+		// rewards level of the latest txn that moved it before
+		// then, or 0 as in the genesis allocation if there was
+		// none. (Otherwise the recorded RewardsBase is current
+		// from whatever previous txn happened to this account.)
 		tf.MaxRound = round
 		tf.MinRound = 0
 		tf.Limit = 1
-		txns = db.Transactions(context.Background(), tf)
-		for txnrow := range txns {
+		tf.AddressRole = idb.AddressRoleSender | idb.AddressRoleReceiver | idb.AddressRoleCloseRemainderTo
+		rewardsBase = 0
+		for txnrow := range db.Transactions(context.Background(), tf) {
 			if txnrow.Error != nil {
 				err = txnrow.Error
 				return
 			}
-			var stxn types.SignedTxnWithAD
-			err = msgpack.Decode(txnrow.TxnBytes, &stxn)
-			if err != nil {
-				return
-			}
 			var baseBlock types.Block
 			baseBlock, err = db.GetBlock(txnrow.Round)
 			if err != nil {
 				return
 			}
-			prevRewardsBase := baseBlock.RewardsLevel
-			var blockheader types.Block
-			blockheader, err = db.GetBlock(round)
-			if err != nil {
+			rewardsBase = baseBlock.RewardsLevel
+		}
+	}
+
+	var blockheader types.Block
+	blockheader, err = db.GetBlock(round)
+	if err != nil {
+		return
+	}
+	var proto types.ConsensusParams
+	proto, err = db.GetProto(string(blockheader.CurrentProtocol))
+	if err != nil {
+		return
+	}
+	acct.PendingRewards = idb.PendingRewards(proto, status, acct.AmountWithoutPendingRewards, rewardsBase, blockheader.RewardsLevel)
+	acct.Amount = acct.AmountWithoutPendingRewards + acct.PendingRewards
+	acct.Rewards = rewarded + acct.PendingRewards
+	acct.RewardBase = &rewardsBase
+	acct.Round = round
+	return
+}
+
+// holdingAtRound is an asset holding as it is rewound.
+type holdingAtRound struct {
+	amount  uint64
+	frozen  bool
+	optedIn bool
+}
+
+// undo takes back amount the holding of addr received in txnrow.
+func (h *holdingAtRound) undo(addr atypes.Address, amount uint64, txnrow idb.TxnRow) error {
+	if amount > h.amount {
+		return fmt.Errorf("undoing txn r=%d i=%d, holding of %s is %d, less than the %d it received", txnrow.Round, txnrow.Intra, addr.String(), h.amount, amount)
+	}
+	h.amount -= amount
+	return nil
+}
+
+// frozenAtRound finds whether the holding of assetId by addr was frozen
+// at round by its latest afrz up to then, or else by the asset default.
+func frozenAtRound(assetId uint64, addr atypes.Address, round uint64, defaultFrozen bool, db idb.IndexerDb) (frozen bool, err error) {
+	frozen = defaultFrozen
+	tf := idb.TransactionFilter{
+		AssetId:     assetId,
+		Address:     addr[:],
+		AddressRole: idb.AddressRoleFreeze,
+		MaxRound:    round,
+		Order:       idb.TxnOrderDesc,
+		Limit:       1,
+	}
+	for txnrow := range db.Transactions(context.Background(), tf) {
+		if txnrow.Error != nil {
+			err = txnrow.Error
+			return
+		}
+		var stxn types.SignedTxnWithAD
+		err = msgpack.Decode(txnrow.TxnBytes, &stxn)
+		if err != nil {
+			return
+		}
+		frozen = stxn.Txn.AssetFrozen
+	}
+	return
+}
+
+// AssetSupplyAtRound rewinds supply of asset from supply.Round back to
+// round by undoing the asset's txns after round. It returns ok=false
+// when the asset didn't exist at round. Holdings don't keep their whole
+// history, so some of it is inferred: a holding is opted in at round by
+// its latest opt-in and opt-out, and a holding with an undone afrz is
+// frozen as set by its latest afrz before round, or else by default.
+func AssetSupplyAtRound(asset idb.AssetRow, supply idb.AssetSupply, round uint64, db idb.IndexerDb) (out idb.AssetSupply, ok bool, err error) {
+	ctx := context.Background()
+
+	if asset.CreatedRound != nil && *asset.CreatedRound > round {
+		return
+	}
+	// only the role addresses of the params change, as of the latest
+	// acfg before round, or the current ones for an asset from before
+	// its history
+	params := asset.Params
+	destroyed := false
+	for row := range db.AssetConfigHistory(ctx, asset.AssetId) {
+		if row.Error != nil {
+			err = row.Error
+			return
+		}
+		if row.Round > round {
+			continue
+		}
+		destroyed = row.Params == nil
+		if row.Params != nil {
+			roles := idb.RoleParams(*row.Params)
+			params.Manager = roles.Manager
+			params.Reserve = roles.Reserve
+			params.Freeze = roles.Freeze
+			params.Clawback = roles.Clawback
+		}
+	}
+	if destroyed {
+		return
+	}
+	var creator atypes.Address
+	copy(creator[:], asset.Creator)
+
+	holdings := make(map[atypes.Address]*holdingAtRound)
+	holding := func(addr atypes.Address) *holdingAtRound {
+		h := holdings[addr]
+		if h == nil {
+			h = new(holdingAtRound)
+			holdings[addr] = h
+		}
+		return h
+	}
+	for row := range db.AssetBalances(ctx, idb.AssetBalanceQuery{AssetId: asset.AssetId, IncludeClosed: true}) {
+		if row.Error != nil {
+			err = row.Error
+			return
+		}
+		var addr atypes.Address
+		copy(addr[:], row.Address)
+		h := holding(addr)
+		h.amount = row.Amount
+		h.frozen = row.Frozen
+		h.optedIn = row.CreatedRound == nil || *row.CreatedRound <= round
+		if row.Deleted && (row.ClosedRound == nil || *row.ClosedRound <= round) {
+			h.optedIn = false
+		}
+	}
+
+	tf := idb.TransactionFilter{
+		AssetId:  asset.AssetId,
+		MinRound: round + 1,
+		MaxRound: supply.Round,
+		Order:    idb.TxnOrderDesc,
+	}
+	refrozen := make(map[atypes.Address]bool)
+	for txnrow := range db.Transactions(ctx, tf) {
+		if txnrow.Error != nil {
+			err = txnrow.Error
+			return
+		}
+		var stxn types.SignedTxnWithAD
+		err = msgpack.Decode(txnrow.TxnBytes, &stxn)
+		if err != nil {
+			return
+		}
+		switch stxn.Txn.Type {
+		case atypes.AssetConfigTx:
+			if stxn.Txn.ConfigAsset == 0 {
+				// created after round, without a recorded created round
 				return
 			}
-			var proto types.ConsensusParams
-			proto, err = db.GetProto(string(blockheader.CurrentProtocol))
+			if stxn.Txn.AssetParams.IsZero() {
+				// unwind destroy, the creator had all of it back
+				h := holding(creator)
+				h.amount = params.Total
+				h.optedIn = true
+			}
+		case atypes.AssetTransferTx:
+			sender := stxn.Txn.AssetSender // clawback
+			if sender.IsZero() {
+				sender = stxn.Txn.Sender
+			}
+			if !stxn.Txn.AssetCloseTo.IsZero() {
+				// unwind close out
+				err = holding(stxn.Txn.AssetCloseTo).undo(stxn.Txn.AssetCloseTo, txnrow.Extra.AssetCloseAmount, txnrow)
+				if err != nil {
+					return
+				}
+				h := holding(sender)
+				h.amount += txnrow.Extra.AssetCloseAmount
+				h.optedIn = true
+			}
+			err = holding(stxn.Txn.AssetReceiver).undo(stxn.Txn.AssetReceiver, stxn.Txn.AssetAmount, txnrow)
 			if err != nil {
 				return
 			}
-			rewardsUnits := acct.AmountWithoutPendingRewards / proto.RewardUnit
-			rewardsDelta := blockheader.RewardsLevel - prevRewardsBase
-			acct.PendingRewards = rewardsDelta * rewardsUnits
-			acct.Amount = acct.PendingRewards + acct.AmountWithoutPendingRewards
-			acct.Round = round
+			holding(sender).amount += stxn.Txn.AssetAmount
+		case atypes.AssetFreezeTx:
+			refrozen[stxn.Txn.FreezeAccount] = true
+		}
+	}
+	for addr := range refrozen {
+		holding(addr).frozen, err = frozenAtRound(asset.AssetId, addr, round, params.DefaultFrozen, db)
+		if err != nil {
 			return
 		}
-
-		// There were no prior transactions, it must have been empty before, zero out things
-		acct.PendingRewards = 0
-		acct.Amount = acct.AmountWithoutPendingRewards
 	}
 
-	acct.Round = round
+	out = idb.AssetSupply{Round: round, Total: params.Total}
+	for addr, h := range holdings {
+		if !h.optedIn && h.amount == 0 {
+			continue
+		}
+		if !params.Reserve.IsZero() && addr == params.Reserve {
+			out.Reserve += h.amount
+		}
+		if addr == creator {
+			out.Creator += h.amount
+		}
+		if h.frozen {
+			out.Frozen += h.amount
+		}
+		if h.amount > 0 {
+			out.Holders++
+		} else {
+			out.ZeroBalance++
+		}
+	}
+	ok = true
 	return
 }
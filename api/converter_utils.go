@@ -5,7 +5,6 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/algorand/go-algorand-sdk/crypto"
@@ -87,19 +86,49 @@ func decodeAddressRole(role *string, excludeCloseTo *bool, errorArr []string) (u
 		return idb.AddressRoleFreeze, errorArr
 	}
 
+	if lc == addrRoleAssetManager {
+		return idb.AddressRoleConfigManager, errorArr
+	}
+
+	if lc == addrRoleAssetReserve {
+		return idb.AddressRoleConfigReserve, errorArr
+	}
+
+	if lc == addrRoleAssetFreeze {
+		return idb.AddressRoleConfigFreeze, errorArr
+	}
+
+	if lc == addrRoleAssetClawback {
+		return idb.AddressRoleConfigClawback, errorArr
+	}
+
+	if lc == addrRoleRekeyTo {
+		return idb.AddressRoleRekeyTo, errorArr
+	}
+
 	return 0, append(errorArr, fmt.Sprintf("%s: '%s'", errUnknownAddressRole, lc))
 }
 
 const (
-	addrRoleSender   = "sender"
-	addrRoleReceiver = "receiver"
-	addrRoleFreeze   = "freeze-target"
+	addrRoleSender        = "sender"
+	addrRoleReceiver      = "receiver"
+	addrRoleFreeze        = "freeze-target"
+	addrRoleAssetManager  = "asset-manager"
+	addrRoleAssetReserve  = "asset-reserve"
+	addrRoleAssetFreeze   = "asset-freeze"
+	addrRoleAssetClawback = "asset-clawback"
+	addrRoleRekeyTo       = "rekey-to"
 )
 
 var AddressRoleEnumMap = map[string]bool {
 	addrRoleSender: true,
 	addrRoleReceiver: true,
 	addrRoleFreeze: true,
+	addrRoleAssetManager: true,
+	addrRoleAssetReserve: true,
+	addrRoleAssetFreeze: true,
+	addrRoleAssetClawback: true,
+	addrRoleRekeyTo: true,
 }
 var AddressRoleEnumString string
 
@@ -110,8 +139,17 @@ var SigTypeEnumMap = map[string]int {
 }
 var SigTypeEnumString string
 
+// AccountStatusEnumMap maps status to idb.AccountQueryOptions Status
+var AccountStatusEnumMap = map[string]int {
+	"offline": 0,
+	"online": 1,
+	"notparticipating": 2,
+}
+var AccountStatusEnumString string
+
 func init() {
 	SigTypeEnumString = util.KeysStringInt(SigTypeEnumMap)
+	AccountStatusEnumString = util.KeysStringInt(AccountStatusEnumMap)
 	AddressRoleEnumString = util.KeysStringBool(AddressRoleEnumMap)
 }
 
@@ -126,6 +164,42 @@ func decodeBase64Byte(str *string, field string, errorArr []string) ([]byte, []s
 	return nil, errorArr
 }
 
+// decodeGroupID decodes a 32 byte group id from standard or URL-safe base64, or appends an error to errorArr
+func decodeGroupID(str *string, field string, errorArr []string) ([]byte, []string) {
+	if str != nil {
+		data, ok := decodeBase64Digest(*str)
+		if !ok {
+			return nil, append(errorArr, fmt.Sprintf("%s: '%s'", errUnableToParseGroupID, field))
+		}
+		return data, errorArr
+	}
+	return nil, errorArr
+}
+
+// decodeProgramHash validates a base64 logic sig program hash, or appends an error to errorArr
+func decodeProgramHash(str *string, field string, errorArr []string) ([]byte, []string) {
+	if str != nil {
+		data, ok := decodeBase64Digest(*str)
+		if !ok {
+			return nil, append(errorArr, fmt.Sprintf("%s: '%s'", errUnableToParseProgramHash, field))
+		}
+		return data, errorArr
+	}
+	return nil, errorArr
+}
+
+// decodeBase64Digest decodes 32 bytes of standard or URL-safe base64.
+func decodeBase64Digest(str string) ([]byte, bool) {
+	data, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		data, err = base64.URLEncoding.DecodeString(str)
+	}
+	if err != nil || len(data) != len(sdk_types.Digest{}) {
+		return nil, false
+	}
+	return data, true
+}
+
 // decodeSigType validates the input string and dereferences it if present, or appends an error to errorArr
 func decodeSigType(str *string, errorArr []string) (string, []string) {
 	if str != nil {
@@ -139,6 +213,40 @@ func decodeSigType(str *string, errorArr []string) (string, []string) {
 	return "", errorArr
 }
 
+// decodeTxnOrder validates the input string and converts it to an idb.TxnOrder, or appends an error to errorArr
+func decodeTxnOrder(str *string, errorArr []string) (idb.TxnOrder, []string) {
+	if str != nil {
+		switch strings.ToLower(*str) {
+		case "asc":
+			return idb.TxnOrderAsc, errorArr
+		case "desc":
+			return idb.TxnOrderDesc, errorArr
+		default:
+			return idb.TxnOrderDefault, append(errorArr, fmt.Sprintf("%s: '%s'", errUnknownTxnOrder, *str))
+		}
+	}
+	// Pass through
+	return idb.TxnOrderDefault, errorArr
+}
+
+// decodeStatsInterval validates the input string and converts it to an idb.StatsInterval, or appends an error to errorArr
+func decodeStatsInterval(str *string, errorArr []string) (idb.StatsInterval, []string) {
+	if str != nil {
+		switch strings.ToLower(*str) {
+		case "round":
+			return idb.StatsIntervalRound, errorArr
+		case "hour":
+			return idb.StatsIntervalHour, errorArr
+		case "day":
+			return idb.StatsIntervalDay, errorArr
+		default:
+			return idb.StatsIntervalDay, append(errorArr, fmt.Sprintf("%s: '%s'", errUnknownStatsInterval, *str))
+		}
+	}
+	// Pass through
+	return idb.StatsIntervalDay, errorArr
+}
+
 // decodeType validates the input string and dereferences it if present, or appends an error to errorArr
 func decodeType(str *string, errorArr []string) (t int, err []string) {
 	if str != nil {
@@ -342,29 +450,77 @@ func txnRowToTransaction(row idb.TxnRow) (generated.Transaction, error) {
 
 func assetParamsToAssetQuery(params generated.SearchForAssetsParams) (idb.AssetsQuery, error) {
 	creator, errorArr := decodeAddress(params.Creator, "creator", make([]string, 0))
+	manager, errorArr := decodeAddress(params.Manager, "manager", errorArr)
+	reserve, errorArr := decodeAddress(params.Reserve, "reserve", errorArr)
+	freeze, errorArr := decodeAddress(params.Freeze, "freeze", errorArr)
+	clawback, errorArr := decodeAddress(params.Clawback, "clawback", errorArr)
 	if len(errorArr) != 0 {
 		return idb.AssetsQuery{}, errors.New(errUnableToParseAddress)
 	}
 
-	var assetGreaterThan uint64 = 0
-	if params.Next != nil {
-		agt, err := strconv.ParseUint(*params.Next, 10, 64)
-		if err != nil {
-			return idb.AssetsQuery{}, fmt.Errorf("%s: %v", errUnableToParseNext, err)
+	// AssetIdGreaterThan is set from the next token by the handler
+	query := idb.AssetsQuery{
+		AssetId: uintOrDefault(params.AssetId),
+		Creator: creator,
+
+		Manager:  manager,
+		Reserve:  reserve,
+		Freeze:   freeze,
+		Clawback: clawback,
+
+		Name:  strOrDefault(params.Name),
+		Unit:  strOrDefault(params.Unit),
+		Query: strOrDefault(params.Query),
+
+		IncludeDeleted: boolOrDefault(params.IncludeDestroyed),
+
+		Limit: min(uintOrDefaultValue(params.Limit, defaultAssetsLimit), maxAssetsLimit),
+	}
+
+	switch strOrDefault(params.Match) {
+	case "", "substring":
+	case "prefix":
+		query.Match = idb.AssetMatchPrefix
+	case "exact":
+		query.Match = idb.AssetMatchExact
+	default:
+		return idb.AssetsQuery{}, errors.New(errUnknownAssetMatch)
+	}
+
+	switch strOrDefault(params.Order) {
+	case "", "asset-id":
+	case "relevance":
+		if query.Name == "" && query.Unit == "" && query.Query == "" {
+			return idb.AssetsQuery{}, errors.New(errRelevanceWithoutText)
 		}
-		assetGreaterThan = agt
+		query.OrderByRelevance = true
+	default:
+		return idb.AssetsQuery{}, errors.New(errUnknownAssetOrder)
 	}
 
-	query := idb.AssetsQuery{
-		AssetId:            uintOrDefault(params.AssetId),
-		AssetIdGreaterThan: assetGreaterThan,
-		Creator:            creator,
-		Name:               strOrDefault(params.Name),
-		Unit:               strOrDefault(params.Unit),
-		Query:              "",
-		Limit:              min(uintOrDefaultValue(params.Limit, defaultAssetsLimit), maxAssetsLimit),
+	return query, nil
+}
+
+// statsParamsToStatsQuery also converts generated.LookupAssetStatsParams,
+// which has the same fields.
+func statsParamsToStatsQuery(params generated.LookupStatsParams) (idb.StatsQuery, error) {
+	interval, errorArr := decodeStatsInterval(params.Interval, make([]string, 0))
+	if len(errorArr) != 0 {
+		return idb.StatsQuery{}, errors.New(errorArr[0])
 	}
 
+	query := idb.StatsQuery{
+		Interval: interval,
+		MinRound: uintOrDefault(params.MinRound),
+		MaxRound: uintOrDefault(params.MaxRound),
+		Limit:    min(uintOrDefaultValue(params.Limit, defaultStatsLimit), maxStatsLimit),
+	}
+	if params.AfterTime != nil {
+		query.AfterTime = *params.AfterTime
+	}
+	if params.BeforeTime != nil {
+		query.BeforeTime = *params.BeforeTime
+	}
 	return query, nil
 }
 
@@ -403,10 +559,13 @@ func transactionParamsToTransactionFilter(params generated.SearchForTransactions
 
 	// Address
 	filter.Address, errorArr = decodeAddress(params.Address, "address", errorArr)
+	filter.MsigParticipant, errorArr = decodeAddress(params.MsigParticipant, "msig-participant", errorArr)
 	filter.Txid, errorArr = decodeDigest(params.Txid, "txid", errorArr)
 
 	// Byte array
 	filter.NotePrefix, errorArr = decodeBase64Byte(params.NotePrefix, "note-prefix", errorArr)
+	filter.GroupId, errorArr = decodeGroupID(params.GroupId, "group-id", errorArr)
+	filter.LsigProgramHash, errorArr = decodeProgramHash(params.LsigProgramHash, "lsig-program-hash", errorArr)
 
 	// Time
 	if params.AfterTime != nil {
@@ -419,6 +578,7 @@ func transactionParamsToTransactionFilter(params generated.SearchForTransactions
 	// Enum
 	filter.SigType, errorArr = decodeSigType(params.SigType, errorArr)
 	filter.TypeEnum, errorArr = decodeType(params.TxType, errorArr)
+	filter.Order, errorArr = decodeTxnOrder(params.Order, errorArr)
 
 	// Boolean
 	filter.RekeyTo = params.RekeyTo
@@ -13,26 +13,46 @@ const (
 	errInvalidCreatorAddress     = "found an invalid creator address"
 	errUnableToParseBase64       = "unable to parse base64 data"
 	errUnableToParseDigest       = "unable to parse base32 digest data"
+	errUnableToParseGroupID      = "unable to parse group id, expected 32 bytes of base64 data"
+	errUnableToParseProgramHash  = "unable to parse program hash, expected 32 bytes of base64 data"
 	errUnableToParseNext         = "unable to parse next token"
 	errUnableToDecodeTransaction = "unable to decode transaction bytes"
 	errFailedSearchingAccount    = "failed while searching for account"
 	errNoAccountsFound           = "no accounts found for address"
 	errNoAssetsFound             = "no assets found for asset-id"
+	errNoTransactionsFound       = "no transactions found for"
 	errMultipleAccounts          = "multiple accounts found for this address, please contact us this shouldn't happen"
 	errMultipleAssets            = "multiple assets found for this id, please contact us this shouldn't happen"
+	errMultipleTransactions      = "transaction confirmed in more than one round, specify one of data.rounds with the round parameter"
 	errMultiAcctRewind           = "multiple accounts rewind is not supported by this server"
 	errRewindingAccount          = "error while rewinding account"
+	errRewindingAsset            = "error while rewinding asset supply"
+	errSupplyRewindTooLarge      = "asset supply can't be rewound for assets with more holdings than"
 	errLookingUpBlock            = "error while looking up block for round"
 	errTransactionSearch         = "error while searching for transaction"
+	errTransactionsPruned        = "transactions before this round are no longer available"
+	errUnknownBlockTransactions  = "unknown transactions option [valid options: full, ids, none]"
+	errUnknownAccountOrder       = "unknown order [valid orders: address, balance-desc]"
+	errUnknownBalanceOrder       = "unknown order [valid orders: address, amount-desc]"
+	errOrderWithRound            = "order by balance cannot be combined with round"
+	errUnknownTxnOrder           = "unknown order [valid orders: asc, desc]"
+	errNextTokenOrder            = "next token is for the opposite order"
+	errNextTokenMismatch         = "next token is not from this query"
+	errUnknownAssetMatch         = "unknown match [valid matches: substring, prefix, exact]"
+	errUnknownAssetOrder         = "unknown order [valid orders: asset-id, relevance]"
+	errRelevanceWithoutText      = "order by relevance needs a name, unit or query"
+	errUnknownStatsInterval      = "unknown interval [valid intervals: round, hour, day]"
 )
 
 var errUnknownAddressRole string
 var errUnknownTxType string
 var errUnknownSigType string
+var errUnknownAccountStatus string
 
 func init() {
 	errUnknownAddressRole = fmt.Sprintf("unknown address role [valid roles: %s]", AddressRoleEnumString)
 	errUnknownTxType      = fmt.Sprintf("unknown tx-type [valid types: %s]", importer.TypeEnumString)
 	errUnknownSigType     = fmt.Sprintf("unknown sig-type [valid types: %s]", SigTypeEnumString)
+	errUnknownAccountStatus = fmt.Sprintf("unknown status [valid statuses: %s]", AccountStatusEnumString)
 }
 
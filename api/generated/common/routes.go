@@ -69,116 +69,155 @@ func RegisterHandlers(router interface {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/4/ctvHov0LsKxC7b3V3cZoCMVAUrl0jRu3U8F1S4Pn8UK402mVOIlWS2r2N3/3v",
-	"D5whJUqidvfOrpPi05/sE78Nh/N9htyPi1zVjZIgrVk8/bhouOY1WND4F89z1UqbicL9VYDJtWisUHLx",
-	"NLQxY7WQ68VyIdzXhtvNYrmQvIa+jxu/XGj4Vys0FIunVrewXJh8AzV3E9t943p3M91ma5X5KZ7RFK9e",
-	"LO4ONPCi0GDMFMq/y2rPhMyrtgBmNZeG567JsJ2wG2Y3wjA/mAnJlASmSmY3g86sFFAV5ixs8l8t6H20",
-	"S7/44S3xaq00l0VWKl1z63bgx90dbfYrZFpVMN3jc1WvhISwI+g21B0ms4oVUGKnDbfMQef2GTpaxQxw",
-	"nW9YqfSRbRIQ8V5BtvXi6fuFAVmAxpPOQWzxv6UG+AUyy/Ua7OLDcoSYO7e50oLOrKgTW3vlT06DaStr",
-	"GPbFPa7FFiRzo87Ym9ZYtgLGJXv38jn75ptvvmOERguFJ9DZXfWrx3vqTqHgFkLzKYf67uVzXP/Sb/DU",
-	"XtwYSDPaM9fCXr2Y20AYmCA/IS2s8RwGnONGJBiq/8xbu8ncec+fiGdtw3IlS7FuNRSOjFoDxFSmAVkI",
-	"uWY3sJ/FfbfMv491VlAqDSeSF3X+rPQVr/+rEljeag0y32drDRxpfsPlFCXvPCrMRrVVwTZ8i/vmNQp7",
-	"P5a5sXTOW161DkUi1+pZtVaGcY/BAkreVpaFhVkrKyds3GyeZpkwrNFqKwoolk7+7jYi37CcG5oC+7Gd",
-	"qCqH/tZAMYfm9O4OsMRdjBIH14PwgRv67SKj39cRTMAtMkKWV8pAZtURJRP0BpcFi9VCr3HM/VQOu9oA",
-	"w8VdA6lbxJ10BF1Ve2bxXAvGDeMsKJglEyXbq5bt8HAqcYPj/W4c1mrmkIaHM9CGzgSZQ98EGQnkrZSq",
-	"gEtEXiVqYacYe8NvRd3WTLb1CrTbexAzVjENttVyDgKa8ciZ1fw206qVxQlq0zKlY+lmGshFKaBg3Sxz",
-	"sPTLHINHyPvB0yvzCJwwySw43SpHwJFwmzgUR2euhTV8DdGZnLEfPZthq1U3IDtuZKs9NjUatkK1phs0",
-	"AyMuPa/SEDplIWs0lOJ2CuSlR4cjderjZUHtFVGupOVCQuHEBAKtLBDbzMIULXhfbbviBv74hzlV07dq",
-	"uIF9UnqMCYC209nlG9dCYw/volvhCEueSIelGtPfQdo7ie6wU0ZMn1AnrtWLhLTPNBh/gtcUr23EOqPP",
-	"E5IS6ysngUtRoXT+2VFSQENrnKE2RESQ10asJbethqfX8vfuL5axS8tlwXXhvtT06U1bWXEp1u5TRZ9e",
-	"q7XIL8V6BpkdrElXAofV9I+bL+062Ntuu6klQnNqhYa7jjew1+DW4HmJ/9yWiHVe6l/mlkzZ6K+Vummb",
-	"GIX5wIFc7dmrF3NkhVOe6g9f3U4td/qGDGgaJQ2gI+wd5Hf+m/vk5AZIFIu8aSqRcwfd+c9GoeXTQ9Bo",
-	"1YC2AuIwgPvv7zSUi6eL/3Xehw3OaZg59wv2xqad0wfEBdx6OUD87yUDaCff6qa1ZOCkWKzjifcdbOM1",
-	"+8NTq58ht4s7N3IIxiOoG7t/7AD2sJvPhy38v7BQm3vgzYPMteb7fzMeSUNmqOmmM/9ooEDx2PC1kLjx",
-	"JdttQLKa3zhpwaWyG9DMnQUYG3QlmVqkPrt4hFe43vw6W6T4KnGm5pMP1VnMf+EVlzl8jpNd+alOPtk3",
-	"QgoE4ntVFd4P++8RuyPuUPk5jvhzMK2b5yijYqcvK95wyc+BJPO5sHQPwRbw9V+a787ykyn+L5XKbx50",
-	"loeOCmc9svL3wCu7eb6Bf8P60dxHoLiKgvifgaT/h5DichEnP07m4AjZUz4ekfcQk6MF70Xkd8EYju3Y",
-	"RGDcZ6CEJF/UGdrcMu5jt+TKXctr+QJKIYVrf3otC275+YobkZvz1oD29sHZWrGnzE/5glt+LRfLsfib",
-	"yzJheM5D07SrSuTsBvapU6C44XSG6+v3zrO+vv7ArLK8iqJGUTTRe/u9zTslOVogc5ShWpv5KHymYcd1",
-	"kQDddLEGnJnCmodWXTI/N4VEfJTfz59mg15tTDftmtyuqQ/bQOXDLcKEFfEMf1DWBwr4jhENsdaAYf+s",
-	"efNeSPuBZdftxcU3wGKL65/ex3css28o5Hey6jpgtR3IkFxfv8fkB55llI3jay6kCZLE+dUOcT6uvAKW",
-	"O+EHxRl7VTLkhOVguE9Lei7ryE0YCgWzK7dHDJ6wnEsMETcFhkyFZFzux/6oAWuD2/8ObmB/FcVe7pmD",
-	"ySk4mx066IZrh5EoQqzKcOp+/OzBP+1OPmz70NF/0pmnDrvh2opcNJy2c5I393Ywxk1yjBOTvKfKMYsR",
-	"O0ZISrIcdc5W3EDyOMC1uPNwxMM4RsdtoLGwEik33MEZw0y616yrCuPsXQ6QSJprTACEbVNObA60NJWA",
-	"lr0IDGAMMRLL2g03IReAKZO8ahFVJ0mlGY1/5RCAWt9RUVD7wgzUjHDrVrDlc/ifj8S9koWzTcAM8yJd",
-	"nC1w9JgZll30l4oUQjwuBOFC5G2xvFcUbbkwlts2fRxKVu44CqhgTRunzoFQPGhfmeiAHBx/L8tKSGAZ",
-	"E91uLe6W8lgqF5TM6YWYXwOcxv49c9TmJjh5hhQZR2A3SlU0MftBxbwp1/cBUoJAk4yHuZVmUkV/wwle",
-	"QFct4m2Bozp7Kjt6Jlr2QWk6xqmh1QW33o7FWNKcGvRi1GXlzYNIeKdI1Imm3Nnj0rSYy7QqV9XZxI4y",
-	"UAHqoWwgWTNnMyXVKSAZXoZhkY3FHonSabfHQTFWe6ZhLYwF7e1rhLCL6/dpi70FBxm3FrRb6P8++vPT",
-	"98+y/8OzXy6y7/73+YePf7h7/PvJxyd3f/rT/xt++ubuT4///LuUubdVFrJSaGOzLa9SkePr6/eu00uD",
-	"VtBL1zUtfgaoYpRsFjN+By57A/usEFWbPm2/7t9euGV/6IxN065uYI9KBni+YStu8w1qocHyrs+BpSt+",
-	"dMOvacOv+Wfb72m05Lq6hbVSdrTGfwhVjeTJIWZKEGCKOKanNovSpHgJsbO5LOZKeTe1leJfLTBRgLSu",
-	"SWMFwUiyOOyGsoiJ6BCygETG1E9MJRL99EliwaVOMwbfUtcxygmIbqZZnAT/YQLui06qho12jo/7EFm/",
-	"93Bd4xUnnusBt9NxQ+9ttlJ4NyzBamlHoBXSUgL4eBFb0M0bAnRmjWRRGjoJKuFwPQslJU55B1eC9JIb",
-	"7V0jYQbu1G4DvhZiRHr9wOBHlY7Ll1TsUhmVmKaVOy6pOsWNIxz60QZIMbpRO+XEe84NJEM0wmSlVr9A",
-	"WlyX7qB2G0ALBA0PG6PSbZBGR3MPcuKxEdKZHn31YMBvDMcsab/tmChxztTIhqGFGQ5HKo/8Q4yABSuO",
-	"SyLr51hjGHuwM8wRx5zOaf6eOTzMY97IK75b8fwmifTcwfSsd8EH9qZVLAwOp+BN4572Im++6+ssSad9",
-	"QNfCDoOHPTHMkvtVRH7/8SRfQC5qXqXdjwKxjyU7nXwqxFpQCVVrICoh8hOxRglpiYoKYZqK7ynI0aPm",
-	"VckullFFnT+NQmyFEasKsMfX1MN5ybi3zuMJQ9z2QNqNwe5PTui+aWWhobAbQ4g1ijm/+qorHOwcvBXY",
-	"HYBkF9jv6+/YI3RtjdjCY4fFmirLFk+//g7LruiPi5Sy83WHh+RKgYLlH16wpOkYfXuawykpP2tK0ISS",
-	"73kRdoCbaOgpvIQ9vdQ7zks1l3wN6VhdfQQmGouniZbxCC+yoEpHY7XaM2HT64PlTj5lG242aS1MYLBc",
-	"1bWwtWMgq5hRtaOnviqHFg3TUdkk6eEOrtCIcYQGax0dIfYpkS/vBZEuT+0aoz0/8BqGaF06V960Dua+",
-	"+s4LxCSCNRjQ2/QieuaAg970Y9kjqWRWO94pHnt5NqS/ZFZFWV4ll7VBdo3j+YenPtXUcrNks4htB4jl",
-	"kUx6MIpbnd4nb91SP7577RVDrTQMUzKrkCwYqBgNVgvYJjl2nFnqLJNOXQTMpwwUymVOYMXPMWRzBrZS",
-	"NzcAjZDr85UbQyYEzTo2HtYgwQgzz9jrjUOPa3asGPm3ODVbQaXk2nx5ngyAz7joa0AKevXiGNSTiUOR",
-	"bIZd5xHj+rkl3oaiWpra9f/y2Ihi0kez5O983/kQshM6lP957rM1FOFQcorKHTdOOIMsSN0gG264kDNx",
-	"ZYBiJkYGuOKl0lZQqBbgV4h4WVGDsbxu0kLROBiJE5GrHaDdEGclGciVLAwzQubAoFFmk0TEOJ09XepW",
-	"4mKVMCT64st/udJUK4kawKpR0vjUjNHB9PgQxkwrZecARVUR1zUoZRlv7Qak7SLTgFcZxjtxtMM1WkJk",
-	"cJPIYm+cGA61qLyq9ksm7Fc0D8a+UC/UoG8qYFYDsN1GGWAV8C3091Zwtq8Mu7oVhcFbKRXcilytNW82",
-	"ImdKF6DP2EtfSI3WGQ3y612cMZ+69ZH1q1uJ2ysUkOkW75O2GVIhxnHM6C6mWTIlq/3kM172MFBtwZyx",
-	"q50iIExfImGcMhyMWLXopXBWiLIE5FPcDhp1OK5viGDCGzh4D6ib1u/pV+C2W5mhNTNj3FryoG7lc+rE",
-	"fMLODnyqEWvUZEkHgqqgWIN2JreqCe2ihr4kxtkQStvekSyB8lhOsglptSraHKgQ43JAjxFYYgJSdxMj",
-	"ylojDYULUD2cwQkMMtU5Cuh0XZAfKNVwh3h2sAXNVs7L6id6REIngstYrjExDZirp61C8TgtnNtmrXkB",
-	"mbHcwkm65EcacYkDohm26n4T/OT6j82mgW0y0PhpLR3lkpyWiWV5SpbNml7v5hK8L+lel4aKMm94Dwr7",
-	"LieGVQmQGSHTUZkSAGU7z3NoHDnHd7UBnKAiOxNFBVZjBN3qTlhasQXKCR4wBrKcV3lbUez7gKbf5bzS",
-	"wyBqBaVVjsDim4B9qEK4tVYYe6crSLSedgIwGuE4ypHp3vcgKz7c+HHM0Wmr2Sx7VsEW0oY7cEq2f692",
-	"zsndd2fhlujBWBK/IKt0kJOtghkiOu0fvYMRgU/M5KnuMJDuKGaQW8Tn3IAWqhA5E/Jn8NzciaVAMSi+",
-	"cyWtkC1eHdTQw016gmHdwLg2YEoB2vPxFC5OWaQ+cSZhNzjtIrLnhmkmY/kNENihwsGrxlPPVIMRRTsT",
-	"YtE8H0J2P2L0zPuOWzjX3dGaz0SXIwnVMfkhphvT8ohsRqc1xdKsnBoI31OEFe9y2swL6mluO1RBhp4z",
-	"vo+yKsQHQkVXN/cWtPFxmmkoBW6PzO16DOan2lCtGmWgeMAqGW8arbZzQVrXd0/iuKe5YHxRXRCOB5/o",
-	"S2BwpnC2A8DshM032Uwa2/WlHg6Gd2NPa7okmRDIhVCWkNtTYMB8KN2gnYWCmh0UL4AXWMDSp7YpqT0G",
-	"5dEPirmpTWTXSCPQCu3NGpzl8QkMNaG+Y8T/kzqR9rcK/4epmxPYIBgy/uzTQSrq44mnr4vibA8GsdJd",
-	"0Ix4pFGGV+nIc1i0gIrvDy2JHYaLdoZtCL6TzuFOhzmFAreQt3bIMAnTz/PZocVdl/GGO/acckV893B8",
-	"kn/VWum4CH6UjJMMXA8W7gWSV6OwnVcMw8RdzefwAF1bdBexX7MGY/ga0teaY1oMHVMkGJf7T8FmG2ym",
-	"itgO+C8N4+TyVgJQI+qmotC8l6dO+sWj2KEq8gPl4fcMBw9Sx8eSv9MKtMMZ3zjMkXgYAu+iG8arKrzc",
-	"4Fwq568p/DiMV8iCYbzd4FMOkoHcQqUaSPbG+t0TUr3OPYfC3kqK1V7in1e3MtU3ziJj72h7qaJ/zJDT",
-	"KzeZHWLixNhQlMPuqyTCy0gPn/ElJdq6GXGqEvSnzHnl5zihxn0tdTAxQmo45MzccYwjNH1Oug03X/A2",
-	"f19hiVndUIvZB4zoWalujUIUGDZKrvGA0nV85ONQTbJGe7oz1X3GBHP/NLTmwkGY9RXRaR3h+gu5zg4U",
-	"4+RYjeM7htsYqASTm4wnd+SlaygO32TCgMj4ebMd9884ufEz08cV/tlMFVb0bkUoa8Ke7NGrF48ZltSF",
-	"V2x4X/TfP8R2dJMlwFw8YBRCdd7gzBxHqjHLbV+Iib3GNtxRKE/MCX3PDVZW+u4+dvUbTQQNgPRvGUyn",
-	"0qpNx/3XGk2ev+BTIQxkruhZFQsMo+UUjTYb/u3XT86ffPtHVog1GHvG/oHlQBp8ScmoTnt4Gkz09d98",
-	"0ICAdbWGVGzhQ47Rmht/IJPQsvChR5zmy59Q6mRiUsen4aajpNWchEGmyjJZovl3/M6E9IEKHWSEhil2",
-	"T5AS9JzGA/XP3+gtjrvl4kj5cLXtKocfxqAVzF2LqW4TZPrNk6yn1DP22o1mIEulczCsbm3LK3pzKhiA",
-	"MfVQ2Yrtb2dhxYr8BbTCoiHJlPMxxjJZRMjGcCbP0YIzPibvYOgKXbsE/qNLVJZLAvIxa7jQqQctW2lF",
-	"hV8dGn+KsNg4Ae2A/sdGVAkqaJRrNzEcSyYVo7uqcU9KPvXlVwSzz/4PCOkLV6OomaCe9KXhzq7Cqhp0",
-	"kL48gA3f186JfxgbvaXRFC+kZ9IOmzd6xrwJo49dNJt788nN7Rq7qk7/3BNdSPSsG+1xGV8pMpgWRKOv",
-	"f/LO32fsDQaS5E6uly3mnKI0XSimI9egzwPewJ5p8ColvsNCNuEDTEiSsekXJq9EDb3RRdozpbfESfLV",
-	"v7CaNMep4ID4/6sD2+mmOUwVZoYqgjV+iCa6U7gH2V52Y4bvOk1TmvsGhuGTwT26Yb4QHY4z9qLL47pu",
-	"PgPYJ3f9877OzyRRiL2oSrMrmhU6fpfQWKWhwDt419fvG4omJRjXdyDF6PpMVaTvwvNy3V2ETvibodtt",
-	"Cbrvl/L5Qs9S/9J3TLiby096DCvNFv7kMpw5Ee5fDA1wNG4GCn/ZPyPck1FPEUfCE7GnPRfVxBAmFW9W",
-	"lcr9ywsasvCSrf/iTh/rOtu+4vxaPmNOc3vJ002Fz3f28Tyqc/P1LWeJQV0RtpkMGy95zyJ32vzVraTd",
-	"zoQzZiyrWy4KX/Y1KGCm9Hf00q/SvtRDlH6fc7d9H3bb5ugZv5wpMo7PODibvqr4E28P0IoHEDv3OITz",
-	"KXlRjKpQ42vMVHnT1YoTtn21NRIL380UNh88zfLgaR6Yf5AE2QXRceCecBA1lG7aBYzTiFOuo/QRyf4+",
-	"ynTpU5i/C2CdRBpBfH4qcYRVD5DHgRtYvMZi4WfdAwAeONXBd8a8CPEhofBdB6VclUGaBe84xF9GF7Xp",
-	"LShW8+az3u86KjwiiOejYzAbG+tTi/7JiDBfVDXpn+vt2Gp0HfywW3hs6/MvI6Pudq3jhBKPq577dz40",
-	"1JgN7QoXUofjr4h0AbX+7g7FGzE8SBcHw2WJfoUY14y9cjPzasf3JhjdPWHNTxewSrXXCYMvLpfw7/0n",
-	"caNz9NffQS4agU+XDKVgR+PzpurM0zFk8jqhQ3lcse2ixUWLNZG8v3Q19MmDS+6vj/BIQS89mnk1dGdp",
-	"4uBWuD7Pw9xhR92RRvrshBcBEpfxOpQekXk+aHJQ2Hmb874yjkaRkKNl5qWbHF8/nnGwpevkDu0N1zcD",
-	"HcjN8O0QuuE4mHVgYkRp3wc8J+Dd0rf9jW9MSnRO4k+gKa7yjstC1exlK4kKHv307uVj/wRYILJQQOiI",
-	"z0PyG35poJy+NJC4b+9Q8rneGLgpfqU3BqrJGwMP3+nprwsE2pp7WwApTUgfiFgLY7Wvg48l1Jd/VOCQ",
-	"mAlBpcNyxvu/9xU0fhhJGr/SwwwpsqNmnk6z3R2LkYr8JHNk8DIRt/QqoPH393qzZJgl7G/Syi7ZF6c6",
-	"j2URh/OlM4nBIsFF8MJX4pkb4x9K6n7MqX+NrMJ6FLoBXEVmQtnKwoxQSHsVh6NOB60EbySEPgcDWHPq",
-	"81SdeRmHp4aQYPjHl2l2DzJFbwGhgsBbmXT/Eh/Fin40Koo/9qgMPyMwLWCp1FrkhmIV942TvQ5j75aL",
-	"uq2seOA8b8JYCtylNabA0FR4951B8eTbb7/+rt/ub0xcTZGUTPb4bb3Vaq15ze3o0fZ+dycIsXCUZ2s1",
-	"FVl6PeM3awQAoWRcr1sn+sySrQYJqMF1pOkVkNGlIwQkvd9osyEsvtozHpG6cgZuZUX/aem+bbjZ9KJz",
-	"+Cghl5x5eTVOnF399dlrR/1uwS9vEQWmyD4pHD1ijznB0TPJb4E3YvFI9HCqSHwTSZLpVXm/RQpQOnoJ",
-	"d6gR100FzrbrZeCUb3K9b6w6D0dDKj+sSb8VMXoqLJovjXXsgHdslbNEGi7oacnI4kJXuofqAbf7Jvi5",
-	"jOFKXf3baDAOonQOY6Ovrz+kjU0qI0xbl+lBd/c828sRTocYJ7zNWrjNDQHxZXn5CA18eZDuku8ZC1mq",
-	"8BY1z9FuDO8Y+dDSwl+kX2ysbczT8/PdbncW4k5nuarP11hflVnV5pvzMNHkreQwn7+d56RwtbciN+zZ",
-	"21doMwlbAT03CbcY3+ooa/Hk7MLNqBqQvBGLp4tvzi7OviaMbZAIzqkCl2644z4ciaBh9KrA37a6gbiG",
-	"d/TTI08uLn6Fl8H9qySJp7bljVQ7ybBcmp6Xbuua6z3+vptttTTsycUFE6WvPMZfLLTcae33CypmXXxw",
-	"4863T86j3/cYfTn/2P/g6d2R5vPRBebQN7ygO/j7/GMIDd0daDqPfp9itk96Wbqvcf4x/vGhaKnxIAy6",
-	"6C3+Suz7jyOKhlteNxUgMS/uPnSI7Hihwh/LWdwtuy/0S3CLuw93/z8AAP//dt5rAJB2AAA=",
+	"H4sIAAAAAAAC/+x9/Y/ctpLgv0L0LfDsd60Zx9m3QAw8LPLiNWKskxieSRY4O4flSNXdfKMm9UhqZjq5",
+	"+d8PVfwQJVFq9Xjs+A7vl8TT4kdVsVgs1hd/X5Vq3ygJ0prVi99XDdd8DxY0/cXLUrXSFqLCvyowpRaN",
+	"FUquXoRvzFgt5Ha1Xgn8teF2t1qvJN/D6kXaf73S8I9WaKhWL6xuYb0y5Q72HAe2hwZbx5Huiq0q/BDf",
+	"uiFev1zdz3zgVaXBmDGUP8n6wIQs67YCZjWXhpf4ybBbYXfM7oRhvjMTkikJTG2Y3fUas42AujJnAcl/",
+	"tKAPCZZ+8nmUeL1Vmsuq2Ci95xYx8P3uj372MxRa1TDG8Tu1vxISAkYQEYqLyaxiFWyo0Y5bhtAhnqGh",
+	"VcwA1+WObZQ+Y5c4hjFgiz8znNIwriEdGQwzYNnVgXHpWrJSyY3YplRbMy4rpuEaDoVVTBgaQcIt463d",
+	"KS1+E3IbQUBoXOMjZHZESGkNst2vXrxfGZAVaOK0EsQN/XOjAX6DwnK9Bbtarxxaey75FnT8W4MBfQPx",
+	"b9cr/lnW/PaKl9c0tENn9et6sMb3uE4bC7qwYp9ZpdeeCTWYtraGUVsiyVbcgGTY64z90BrLrgDp+u7V",
+	"d+zrr7/+hjmOsFD5vTZJoG72lDyRoSpuIXxewp/vXn1H8194BJe2ckTLyYxv8Qt7/XIKgdAxs5OEtOCW",
+	"rCcEsEdGNnQ/I68VyDrTK+KllPE83GqocEe0Bpx8MA3ICnl1jjnjNJ9OClzBRmlYyF6u8aPyVzr/H8pg",
+	"Zas1yPJQbDVw4vkdl2OSvPOkMDvV1hXb8RvCm+/p3PJ9GfZ163zD6xZJJEqtvq23qhN7FWx4W1sWJmat",
+	"rMGYKP0KUTFhWKPVjaigWjMh2e1OlDtWcuMlJ7Zjt6KukfytgWqKzHnsZrbEfUoShOtB9CCEvlxidHgd",
+	"oQTcgC5O2fUWT0QiBnZlVwDSHURODCwWAYOJP50cgDvCoChrZQDPonmNIJyweBqnZ2inHpgH6Ac0OX5w",
+	"uhFxh0Rg6/rALHFuxbihQ92dxmsmNuygWnZL7FeLa+rvsUG+2JNWQQTvqS6oL05SfUiMDN2vlKqBS2KP",
+	"rVZtkz2chhJU+J2QKoLUe81Alqpy+F1xA//2r64xMGO5rLiumNLs53dvCsM3wHjd7PgV2CkUIkinsoyb",
+	"e3V/7KuQCY1mMHffu51BHENbdadqZH+/WW5Bx8aqtWu/vZEFzLVoGqhQNfRyYgrrAVBHli20rsBYrQ5z",
+	"WBDEKaSxz8cB2k19FFYL+obXYxDfgNzaXbxhKMtr8+KDRC27lRUr6P5B/8Yfd6rVrEC5iv8iOfrz5Xf4",
+	"peIHVrAnHvCnjOMvocEkGh6qnOpMc67WK5xotV5V/JBXcGuxF3aM1w/8TuzbPZPt/go04hc2kVVMg221",
+	"nKKuG/GITK+N2BaNVlvN98WOm93x3WvEVrrl5axWW1HiL8mlz4/GcLQHb2iShOlI4ZZj+B7Y18/Z1cGC",
+	"Ydz9mF51JEPo1W3YbChYme1GmyTXiBKfSmjs+V3h2OL4VcYieRKN0zRQio2AisVRphDqpjnCA3shT4On",
+	"u2Al4Ah5BBwhF4JDC8G1FaVouLSnceS+ra1AhvTKh/FM2V7VokQlA3nGGyOENSyZyHiu65oK41V8bqix",
+	"EXJbA83Hbasj403iPETl06kuEu4ylLoku8CdZQ3fQiI9ztjPXmGkr1Zdg4x6JRLSbRi4Eao1sdMEljT1",
+	"NGa4plJZKBoNG3E3BvLCM5FhnLk2/jTZ+ytVqaTlApfYyw4czqlHkzAlE36qfax0BRk1+Cf8OZXUVwd/",
+	"CuGp3ygjsGFA5apW5TWdVNyUeE7VeBqyjdDGrnu3An8Z2IjaAkJP/O4YALsjEKxAK9BE/9sdyIneHyQy",
+	"CuGDPH8NjY2kjvyxZkYxYZlCw58EqOgMugq3YOXa08QMLZJgJg9+R7nccclNiackmDJ/TEYr0VGh4Fgo",
+	"Gih30BnMZjknznBEF1koMTdqKClnpeQiCUmNCqcSZC6j+NUrDHnjca//AvNxOjdKNPfzaBuL7SXebhyL",
+	"Gfb31thIhhZF54AQ4S4UxSltA5TdBbvw2gH+snc//YCS/UJs8afa/fQG1Y8LsZ0gZoQ1a9Okbnv3Pxwv",
+	"z3D2LqKbm8LeTc/QcGx4DQcNOAcvN/S/u42zj270b1NT5i5Rb5S6bpuUhGXvAnV1YK9fTrEVDbnUMXB5",
+	"N7b7ud9oA5pGSQPkEfCegnf+N/wJZTW4Q5s3TS1KjtCd/90ospt0EDRaNaCtgNQfgv/8Fw2b1YvV/zjv",
+	"/Cfnrps59xN2pio7pbm4XcCtlwNu/3vJQJcstW9a68wjuS0W98T7CNtwzm7x1NXfobSre+zZB+MJ7Bt7",
+	"eIoAR2Ldcl2ZR6DZJ6QAEoDAXLggHqkR6fogdqM+jHTm8RiN/i0s7M0JLOdB5lrzwydmQafQFXTwjkf+",
+	"2UBFJ0vDt0Jy5xOi833Pr8n3I5XdgQ7ncFDtvNkFB+18Wl4/9Fahs1X+2B1tB/PR+8EYsH/jNZclPMbK",
+	"XvmhFq/sD0IKAuJ7Z4P55xKHJY6kfIwl/o58P98LY5U+PIbYo/GKnRtw+T7uYPlux+UWPvNyDyVjH4vH",
+	"IPRjSEccZxElP/MRTFM+BpEuLH+cc2TSFdtK8Y82eGJEBdKiuquzEuCTiheDqJ62P4g6442RWw1nVh8e",
+	"8G7OBy1M2zT14UtXiwxBuYyWrukxlciP+BCSPRofn8gl/zwoezvh44/J1u4e73wkR+nJp2Nrd1/CsdgD",
+	"/iPJ+jc0rT2IoHOUolGPzPw98BrpCZ9g/mTsI1D84E3hj3h7+qS7PJjuT9Dg+xgePbiGsreb8UTWehts",
+	"+vjpEVXbT0neJoX5ZAHRw3hKUhwhdx6CE0l/Yfk1fOm0lsoWCbZyW+yVhEPGv9tFAe2gdj6sXvhMpZhU",
+	"tnNTWcjPqDabWkg4YR7fI843Ma48dVh5dNQjbDJFvCGSA+BO56MvXySepsIv094fRWW/7CzPXzoNEyP5",
+	"MQImWB0lWzrsw4n3xXMgcF0LMJOj/+T8lfSV1HA/S4IiRQXxGy5qflXDGUvR72IbhPGDUMCgjxXcq5vO",
+	"H02R5PpPhmlAMgklWaNqUR7O2I/KUoQb6f28rsfzo0/zS79c9Jhq+bbvce2Jm7834UlsfB/cSaknKBOY",
+	"7j4wIZ0HHVeNW8a919g5Q9ED/RI2QpJr/MUHWXHLz6+4EaU5bw1obyY+2yr2gvkhX3LLP8jVenj9mUpY",
+	"sbt4KiUxHrlVcHG74xE+fHiP8QAfPvzqIsySqKzkIPSO885rNGY5N0GBnKFaW/gQ2CLxufQnNjFCgkam",
+	"3rOzrpkfm3704zM/fn4bdIaAMdL4CbF2beJR73J83Iy0hj8q613t/JY5HmKtAcP+e8+b90LaX1nxoX32",
+	"7GtgqeH9v72XHLfMoXEBqYuNETPG+5lY5Q8f3lPkMa1lEkHGt1xIE2SlEVuJhPNx3RiKgvcuqM7Y6w2j",
+	"nbAeBqDhn36XRXYTxoVis0vEkcIPWMklDtg2FQX0Csm4PAw9ugasDY7zdxiYcJlEL5wYQOTCQQt+5KCo",
+	"WhwuHhbdCrNbbljNjfUBqnk2Kl2AcjHHTg3XSPckShoD96gD8/0n2etF5K8A1hyDfRRnZS0hAb2PoqIL",
+	"klENSKjWTGn/T8d9PrzuCnAAT+p4wIkNa+W1VLcyT/4KarC5GN7/2gEdVErTfaIHkjCBY+vDeHFj0Mt6",
+	"hatfIHfewEPRJwYytDlSZs9jk8Zk5oPbugBU3ywfA/okhIJQ5MjTdRoQ6sJL1cZD6+NMQ88kwq8LATtY",
+	"oOustaARkv/95N9fvP+2+F+8+O1Z8c3/PP/193+9f/rn0Y/P7//61//T/+nr+78+/fd/yR1CvcvyQid1",
+	"75JOgxw7WbJnidoMj4wYsh+240zMQIERctmND/gFd35rXEQw4hhkZpjJqaOEwRmjJFOvC1/VlNUQr8dd",
+	"xHnvsng0nGEMlpbdkR7A6FMk5YgdNyHzglJwMN4LSbXolJ3YNcjKIxUa6Z2qTQLnreGGT9F/Ojbrtazw",
+	"NgGmn4XSBbL6E2oodtcxBtOFzIYIrRCWFWKxVuuT4qrcpbPNL4eSNS4HSrKtQ9w1DoziQfuTSRYI4fjJ",
+	"2zMKJiK2ZEKhTsaoUrjUmU7q+TkANdA/M+Q2HGDxCDk2TsBulKrdwCi+36ZMegqQEgRJbh7GJhGe/A0L",
+	"/BQxkdrrtkd10LHs6DbRepXaDtrcxSHG7LwdirHs9aDXirkmV17dTdSEHIsyIVmppAFpWko+sKpU9dno",
+	"XmCgBjpqir4Z8hoOefUQiA0vQrc0LvyJwEPm8DQ5NjVshbGg/X2xu89+/pPjRlkoSMUobnidc1F/+PAe",
+	"G70ypNW/Ejp3g/fJYsm6uORFMaH50bQYO1uJus2vtp/3P1/itD/Gy5Npr67hQIcM8HLHrrgt6UTuT49t",
+	"ZqYm5WQe4TcO4Tf80fBdxkvYFCfWStnBHP+PcNVAnsxtpgwD5phjvGqTJJ0RL++mzvXLRKVwKmHU6Czj",
+	"nojs7UDN4Tr0KloprKHcbieGhETd3wDbaLVniarjMrGof1HDDdSuPINL27QMuA7JKT3Dfvg+Ul4G5owT",
+	"TQXxTGQ+iCtaAhbpJ6WGSuAFZ4HC1NcYo/7mR3AZvhDBMAL/mypSeBPy96k8MMuR7UEQKN5aIvAB7BRQ",
+	"H6nGhglp1Z1ZMPxEllV3WX7A1Mh7s9g2ZHLEtgzbBuvP+ByM2nUQGrNzmty9UdWQzhVNTQ/ir8mlvEy1",
+	"73VHNcqZcSPPjui2Xi41P12jBdQ4RUEHAz3RcUqgwzH1a7QTpzUyx6p97hmsa09561EsoJwVsiEccCph",
+	"60p527YPeuvC3WjhBuobki7UMhgJuo+wrLiaB4k0+UTmEpqnZyyJmcs+dRBx7eo50P5XEgwTeG2cMKrE",
+	"MT4K9Q6SLKJCVnD3cbGKDrlFlrO3rumQ5x0QcaRJjutF6Y6vDLGajNOg/OlukrpJ5WY7NC71mW0m2X20",
+	"8v2CVbFnV27jjL3t1l2mnihhWSUmlv0B5FyvZtkj3o/H2I9X05d0Gu9t+n1qLGc4o22miET4p6/5xPwt",
+	"lsrhnG6ezmc9vV6C1oSSmpYViBfWUMmKppvkwOBUGMHzMl5NI8/FuA3ZM1af4M9KZ8zrf3k7OuognQvK",
+	"Hc67vnMgLe40Jn8rpHW5rMcrS4V18AUrJubIVory/JIZO1RBQYU4WP47web9JaKf5H+7Ay9fB0dL1zE4",
+	"Vza46l4dr43KDNPKWy5dQRXs52joe5OV2is7t0obS2V2sn7b5edI3444NLsz57KLBMhKDmGKjVa/Qf6C",
+	"vUGuuB1P69cNqel65wdXDR7CQp56GHV40QhMSKsSTLyXI3i6Ej8HIo4DqdYuP7cdlKg4PRjMxJM1TfV5",
+	"7W217oXCey5PF2hSwLyN0j+z29xH1vf6TuhRJGsSpxqdO8EgySVLDtSe9MyLqKSFOXfjdyLKwzxS3EIN",
+	"vxw3lgjTt0l5jtR0ahULnbs6OGqTLgVLHK2xrTDu5gx6L2xf3e625KTQuUyEwP8HgqcUe17n7+kVUR/x",
+	"7U6JSmyFNaEKX1fJww/EGiWk114qYZqaH5z/uSPN6w17tu60n7AalbgRRlCAz+sN+2rt74gGCLd4iocu",
+	"iB5IuzPU/PmC5rtWVhoquzOOsEYxJf1KUU216Ku4AnsLINkzavfVN+wJeWmMuIGnSMW9K6qzevHVN1T9",
+	"xP3xLH9BoOIJcwK3IokbBH2ej0k1dGMM6iKNJbAvkTkp22d2k+u6ZC9RS38cHN9LXsPLwrQ/ApPrS6tJ",
+	"Rt4BXSQ18qoaEzY/P1iO8mmiQhGKPwcG3sD3wu5xA1nFjNojP3UlB9ykYTh3aXPaUIQrfCSXWBOsNkk8",
+	"3uc36DuNKoc12eF+5Hvok3XNuGGUWCS68DkvELMEDmVac5PoiQUOCoXvy55IJYs97p3qqZdnff7LTUxO",
+	"1+y0NsiuYajV/NBLFV4cpZgkbNsjLE9k0oNJ3Oo8nrzFqX5+98YfDHuloR8tdxXiuHpHjAarBdxkd+ww",
+	"6C9qJvG4CJSfVFAuQuTx4PDUXJoNaF9irXf5VjfkpdTu7KMaa0qzih/G92/nHJhQ3FJ/VK+g20SQCJ8e",
+	"6g3Pj7TuaomFcJyiM+/xOQOhsVzbiVKxl2Ifq1xvBl61SBgKGL0Vvv4SDdfdrSLNqOacgVLJynjbOTSq",
+	"3M3EOG98bfE+TJ2bza2SjSuY6HpTHq66zaH5M+1A0lKuDoNhzZrOExIRMYAJFewlmQApW/QWtkf2FN0I",
+	"5DQjx4zNPhLfq9tgubR9PsazOlyjP0nSr9uOxdTV/ud4kZ+WK8lwTq1YMpqQQQcJGkJ+RPx6jJeCL8uP",
+	"FI5aYScN/3RKnIC075FGYH3meJqJw+nhh9L9evUbaFV410lxCqHj5TloJL7Sr3O+hGXg8jC9CjNp3H2W",
+	"HDJVxxKjhexMa45aExhmt2eXc5ox8lIdrr7rdrwhJdzOVSS+SIoLJ3cdGnqQNDB0TIY6xXThtIrR8t7K",
+	"UKb4ROOmqqvFcCbVHpcA6iprfRx4S8zKbj2O2pWPmXHnhplQXyKDTZlsXUruaFL6Od3xU3ZZpa6vARoh",
+	"t+dUjs/ZPNyoQ47bggQjzPRNZLtDfQ4/I9skooeGZldQKy94P+8lIgA+ER6zBVJ5X788BvVo4FAmsqCm",
+	"04TBdjjFW9/eD43tPz81Fpa38inkru30RsFbkssl+M5H/nudU45JiXuWN7jhu5iAcsfFpJ8Gqon4NKAZ",
+	"L5S2xM4Mf/n8lKQIGcv3TRZKaxBGtxPpkEFAY5esjmt23TT5NL5C5CIIXr80mRdmTK/QJmnV9C9X7dJH",
+	"GLtCylB5CZv0/quoTC9rYIz/IENgmMI1JsmdJKLUwjjFPwW2VNpV2KPjwKpBotTS/IXZlLA+jIVWyk4B",
+	"SnfwNFtRKUuPzIC0MXoV6HAaYoI8zjWdTckCnLEf8HALFQyxpv2aCQzmdXEZyroL9x70dY0LCVg4Xxlg",
+	"NfCb5L0cGu1Phl3eicrQstZwJ0qM6G92ogzL+8r7KMnsFXkA53tG5yp00beXd5LQqxQ4m1iKp0MzhEub",
+	"UO00xXjtKqMOf8Yf9gbqG8D6wrfKAWG6tEC6hfZ6YOSSkIyzSmw2QPKE0CFrGfXrPiQw0asPlD0Zh/U4",
+	"/QFS4U4WpKNMWA2tM03fye9cIxZD41Jj9WBr7J2JMjBUDdUW9NoF5AXPc5foisYZpW1nod8AEYoksJBW",
+	"q6otwSUfXvT4MQFLjECKNXE72HxFfP/oRgdn0DiD7EcLLFmznzlBI1Ufw8FTGd1AT5xwTOCi6zBUPkvI",
+	"owrV0/wh0jZbzSsojOUWFp15P7seF9QhGeFGnTbAL9h+qND1dKieZpLXJlL3PXnzuzMnJ8smVcTJYNFX",
+	"7qUNDbWLzu8iOtdj0xVAYYTMu7s2ACTbeVlCY/svXOA3lD1kwCNRQRmIQQfAFZaYWeXyBmaUlqLkddnW",
+	"Lj52RiO5LXmt+zECNWwsmenS12c6H5DAua7aEFYT5tMoAJMeuKOQTQ+5GETaHEfDDSfj9TC+krv4UrTQ",
+	"7PFCG9YCp+jAWCeZ4hFyp1NRFLlb7Z+95TYB320mz3XzQOJSTBC3Ste5AS1UJUom5N/B7+YolgLHkPgu",
+	"lbRCtihomIYObndOMLp5DuMnxxyg/T4ew8VdpHlnQJBw21vtKtE7+6HoBiurpOHNJoT0LF1TDUZU7YTv",
+	"SvOyD9lpzBjq5nIL5zourXkkvhwaIsMmn9t0mUjKlG0GqzWm0qSc6gnfJcKKd/G+XlCPTSUh8z+0nLij",
+	"KauC48X36Ma+AW3yN3ZfRWF2bGzRGx9/wMEbisE4fZaCN1huYcr7jW0PYPo8F5QvlztI/X0oX46CE8Ui",
+	"IgDmVthyV0ykumBb1wJheDe8EY6ndCoE7ULYbKC0S2CgnAlnM5qEwn1GKF4CryjJrbOOhkdb+qA8+VEx",
+	"HNokeo00grTQTq2hUZ6eUOMnzHOU+X9RC3n/RtG/nIvl+DbwHzzvTHj/XBvPPF3uJGcHMESVWNY/2SON",
+	"MrzOu/TDpBXU/DA3JTXoTxoV2xDV4M4cCr3HAwXuoGyn7XFhar/P5ibHJkOE4/Yc74q0Yv1wJf9Da6XT",
+	"0jYD665kgC1YqCbvbjWKvvOakf891jnoLyB+Sy7i3Zx7MIZvIfk2YU8MDXMsmFbXG4PNdvTZVYGIwH9u",
+	"GEd1qzOAGrFvahfz4OUpSr+0F5urnDJTEuVEP/sfFxn5z9jGB8Y2jnOF5wMah1UYM+wYX2VKn0y0vceW",
+	"QjAel2TTIAkrMi6fyfo+qFIM53mAL8RBhHmHmTnedm859UAXspuaTDUuPGTiuSiYMykeBXBkzNtpMOhn",
+	"m3MjJmYiCVBNpYl4WTH17kyWS1KCpdB0o+WYJldPMrukozuKbU2azpmkyLpHOZl7cWU+AeRRqmsscZvl",
+	"gclX+ssVRLjwCMd7kRtwHd4w81UP1qF4gdKjYgP5wKsjfrpZuI846jwuMx67ueiiYFPzAUbLg4owQ6RA",
+	"6/rxIJh+BtHxSBhf8yZ9PGRqgkoYK2RpB+m+FC3Tm4gOBGris7sJx1jXgIx70RNMqVOg3dNcSN8pQDf6",
+	"t4U08MGiC5Cvt6qYigVK7So+IKjhhz3un7Q4ynwYkH8VaSHgJ0Qwoc1tFuaGCxcXAzA1xCOGqrlNdRRN",
+	"9JA7sbaYQT9zEBzFXSzYDEn0Sqx1pcH9MVnn6rH3RMMPR0nueXYBpb/M+L8j6B1Da/TET5CkY9GXypi+",
+	"bOjtYr/31oOYvnQHDCL8ejyVrNow9u9O5sOJLvt1XofvdtMTkoaKg/qHtdG/QgdNpmKorFy5UXpilksG",
+	"8gZq1UC2NYKyJKHGvVNq76QLMLmgPy/vZK5t8odrnaCXq3pJsVz+UZmHVbxNMoW6jEB3SHzMiK9ohG7E",
+	"IL0/ZswQhbygyONW6mBvDHePkJlA14yBu7bL/GlD6dcQCdad5yoWb+q8xy6xNM5RicqlAufmeGDtxtma",
+	"HLpMK1JwH5dOGVau656jvNRFV0JtogKIu7MWM4mnJWWe+oahHCmS6qiWSwyq91DNX2xjoFsyoEvwD/0X",
+	"FJ8sJnLek+dmQ8QvtWRPXr98ykQSCJyUFEjetz6K5AZgyjk4iKdAtWNO65iuZrS56So3UauhQfcolAsD",
+	"2b73xRF9c+/I/kKj13pA+ucwx0Np1eaDlbaa7J9/c++Uh5fLET9Gt20XmmJ2/C9fPT9//pd/Y5XYgrFn",
+	"mPwlWaPBJ+4NCrv1V4OJrmBcrwQmI8CiZcSltIVXz7s5Q7XKfJwTt26Yz79CuZVJWf31y2wvaTV3wqBQ",
+	"m0223MhP9HuwugXXRcjGHFB3gZQIOsiDzp//pM5R5Z7eofVNLDX2sA1aw1QBovouw6ZfPy86Tj1jb7A3",
+	"Awx+LcGwfWtbXjO4ozxIZw3u30YxOdB25YkpL1BiLDddEyRToXzTgJcjsSm2gZekRRofoIMwxGyIGNn/",
+	"xBWZWDsgn+IlTGdYmrXSipp+RTL+klCx4WRFY+y/dqLOcEGj8LtJ4VgzqZzu3WvpItG6JFcHs8+x6jHS",
+	"591OUk14+KWvJYd6FeUukrfkj6hPSzemB26jt663Cx6gWqp6Xr3RE+pN6H2sMu3Us+E4Nn6MufP+xXBn",
+	"y/dbd1QBJeqCqH2S0hfdpKGgd6cwOEmOcn3Tkhk4McaGlGV3NQidwJsA/JGSFr18sF3bydiZe2tUutzp",
+	"mTu3xCL5OlVaBtVxFyXt9v+fZtCJw8xzhZngiqCNz/FEXIUT2PYi9uk/DT6CDD/0fam9wrv94EG6cJyx",
+	"lzGoE5v5cMAu0tPdWume6UQhtXK58OEwBKF9OwqUNVZpqKhoLwZ3ONdyZuP6Bu5gxDbjI9I3QctAfAkg",
+	"c98MzdAQ0LXL3flCy43+rWuYuW6uP+o99fy28CtX0MiZ2J9VXwEn5aZ34CeFhDo26jjiiHkivWlPhTiQ",
+	"ycilI9a1Kv3TIxqKnunarT5lz7ddXY8P8luGJ7eXPHGogmyd0blPo4eg/LNMJ9uVNBt2G055YikRh/zl",
+	"nXTYnpBo+eHD+zsuKp+r0isT4S2Jnj5QMaV93LfYeDynigg+rLbZ0TV+NVHKIV1jOWmOfwhh3YwzhJ3y",
+	"nuKdklfVINe/l2xGYfixIoejts8nJWbhtxPlI2ZXczO7mjPjD8zRXnTMFBYPosbFnt0GirseuYCCacd4",
+	"lzk5nnrJ5o8GrEWskXN4PIQ5wqwz7DFTbYzvqSTDt/HFAA+civCdMS9C3DDxdx0O5XoTpFm4HQf7y6Cy",
+	"O4Fr2J43j1rL7KjwSCCeto5NJzEPPFUmjpeketEAnRFuWD9+/lp4DPUwen4F6eswuoyntSW6h27C21sx",
+	"ijm3OO746epUdRWSnL2RzIM0cCxJ082Q0hoTI1AdrG/5wQSlu2Os6eECVX1FwrHCl8ZOu5tCnja6pPv6",
+	"OyhFI6B7+KO3LpuJZ7tmNF7uVd7LXQjqxBB/1yEE8PCutFX/Th6u5L5ID08O6LUnM6/711k3cLhWYJvv",
+	"wtgBo7ikyXm24AmBTMmzSNIjMs8bTWaFXS7iYIGMc72ckHPTTEs3OaxXPnHBltiIKlxzfd1/Dcb063W7",
+	"6DVZTL1AkgSfPeD9AX8tfduViG9Nekn8BbSzq7zjslJ79qqVjgue/PLu1VP/yl9gspBNBCxC8gU/TbAZ",
+	"P02QKdCPJHmsRwmuqz/oUYJ69CjBwzFd/hxB4K2pxwiI04Q8HovwmV8hmBMzwag0L2cyrv4lgsZ3c5LG",
+	"z/QwRWpv+0lZvVf8bEwMHxyRH6WO9J4ywlxF0L7MYE8t6XsJu3qFMjr7UlfnMS9if7y8JzFoJDQJxdJm",
+	"3sUxvjqGnzF9js/FuLo6i3WiJmwobKRPQhajdmesTrNaglcSQptZA9bU8bn0zLxIzVN9SMj843ZjElva",
+	"PR5EBwQV5HFV7ijH3ccLDvKYO1L6l0szEff0xpoR22PX4xzwb0Lf5H33h4wTYoy94S5/YgoyTV1YLiuu",
+	"KwbV87/85atvOnS/MHE1JlLW2ePReuteq+NWlH2NL2K3QIiFpTzbqrHI0tuJe7MmAAhKxvW29dF9Vz0H",
+	"1GlVEgiQPL4JssEsjlXGElZX2kVbdz9RYBl6RjvR2X+Vk0vOvLwaOs4u/+PbN+EtwM+vEYVNUXyUOXqw",
+	"PaYER7dJvoS9kYpHxw9LReIPiSQZYbj3KDoDJfJLKI5FtG5qQN2uk4HjfVPqQ2PVeVgad+SHOS/EuEh7",
+	"Ol6e6u2VhwphobBT9+pponHRVbqD6gGlPkb0uUjhOi11AH0YOzThH8sTGGuX+U73J67txYCmfYp3SQdZ",
+	"GJprB8Tn3ctHeODzg3SffdBbyI0Kz83zkvTGULPfm5ZWvlzpamdtY16cn9/e3p4Fu9NZqfbnW4qvKqxq",
+	"y915GGj0WHgYz5fqQClcH6woDfv27WvSmYStwb1PiaG8Sc7Ii9Xzs2cuHQokb8Tqxerrs2dnXzmK7YgJ",
+	"zl063orKchEeyCKkGL2uMM6bX0Oa0LdehZQ96v782bOTXt2f24XpNPOP//vaz7kKiJQUxih3ktbOtPs9",
+	"1wd6xci2Whr2/NkzdGY4vF2lN46n9vuVC2Zd/Yr9zm+enyfR2YNfzn/3/ypEdX/k8zlFV+6EsUofjrUN",
+	"8vLYnOf9W+rC0Ts/8GyzQfml0Da8Rt37+/z3YMu6n/l07msZznU/957QMS6jlibUI51skUfBZa6f/+78",
+	"+O72mIBNUV/m/Hf6fx8jquvQ+9OawZ9zBMmD01Mrf7d3vgsZujRu4tWL978PpAjc8X1TAwmQ1f2vkXmj",
+	"/KmVum6b1f06/mKA63K3uv/1/v8OABgbLkxQswAA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
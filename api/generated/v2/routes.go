@@ -24,6 +24,18 @@ type ServerInterface interface {
 	// (GET /v2/accounts/{account-id})
 	LookupAccountByID(ctx echo.Context, accountId string, params LookupAccountByIDParams) error
 
+	// (GET /v2/accounts/{account-id}/auth-history)
+	LookupAccountAuthHistory(ctx echo.Context, accountId string) error
+
+	// (GET /v2/accounts/{account-id}/multisigs)
+	LookupAccountMultisigs(ctx echo.Context, accountId string) error
+
+	// (GET /v2/accounts/{account-id}/participation-history)
+	LookupAccountParticipationHistory(ctx echo.Context, accountId string) error
+
+	// (GET /v2/accounts/{account-id}/rewards)
+	LookupAccountRewards(ctx echo.Context, accountId string, params LookupAccountRewardsParams) error
+
 	// (GET /v2/accounts/{account-id}/transactions)
 	LookupAccountTransactions(ctx echo.Context, accountId string, params LookupAccountTransactionsParams) error
 
@@ -36,14 +48,35 @@ type ServerInterface interface {
 	// (GET /v2/assets/{asset-id}/balances)
 	LookupAssetBalances(ctx echo.Context, assetId uint64, params LookupAssetBalancesParams) error
 
+	// (GET /v2/assets/{asset-id}/config-history)
+	LookupAssetConfigHistory(ctx echo.Context, assetId uint64) error
+
+	// (GET /v2/assets/{asset-id}/supply)
+	LookupAssetSupply(ctx echo.Context, assetId uint64, params LookupAssetSupplyParams) error
+
 	// (GET /v2/assets/{asset-id}/transactions)
 	LookupAssetTransactions(ctx echo.Context, assetId uint64, params LookupAssetTransactionsParams) error
 
 	// (GET /v2/blocks/{round-number})
-	LookupBlock(ctx echo.Context, roundNumber uint64) error
+	LookupBlock(ctx echo.Context, roundNumber uint64, params LookupBlockParams) error
+
+	// (GET /v2/groups/{group-id})
+	LookupGroup(ctx echo.Context, groupId string) error
+
+	// (GET /v2/stake)
+	LookupStake(ctx echo.Context) error
+
+	// (GET /v2/stats)
+	LookupStats(ctx echo.Context, params LookupStatsParams) error
+
+	// (GET /v2/stats/assets/{asset-id})
+	LookupAssetStats(ctx echo.Context, assetId uint64, params LookupAssetStatsParams) error
 
 	// (GET /v2/transactions)
 	SearchForTransactions(ctx echo.Context, params SearchForTransactionsParams) error
+
+	// (GET /v2/transactions/{txid})
+	LookupTransaction(ctx echo.Context, txid string, params LookupTransactionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -55,13 +88,19 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) SearchForAccounts(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"asset-id":              true,
-		"limit":                 true,
-		"next":                  true,
-		"currency-greater-than": true,
-		"currency-less-than":    true,
-		"auth-addr":             true,
-		"round":                 true,
+		"asset-id":                     true,
+		"limit":                        true,
+		"next":                         true,
+		"currency-greater-than":        true,
+		"currency-less-than":           true,
+		"auth-addr":                    true,
+		"ever-auth-addr":               true,
+		"include-closed":               true,
+		"active-since":                 true,
+		"status":                       true,
+		"participation-expires-before": true,
+		"order":                        true,
+		"round":                        true,
 	}
 
 	// Check for unknown query parameters.
@@ -135,6 +174,66 @@ func (w *ServerInterfaceWrapper) SearchForAccounts(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter auth-addr: %s", err))
 	}
 
+	// ------------- Optional query parameter "ever-auth-addr" -------------
+	if paramValue := ctx.QueryParam("ever-auth-addr"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "ever-auth-addr", ctx.QueryParams(), &params.EverAuthAddr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ever-auth-addr: %s", err))
+	}
+
+	// ------------- Optional query parameter "include-closed" -------------
+	if paramValue := ctx.QueryParam("include-closed"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include-closed", ctx.QueryParams(), &params.IncludeClosed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-closed: %s", err))
+	}
+
+	// ------------- Optional query parameter "active-since" -------------
+	if paramValue := ctx.QueryParam("active-since"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "active-since", ctx.QueryParams(), &params.ActiveSince)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active-since: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := ctx.QueryParam("status"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "participation-expires-before" -------------
+	if paramValue := ctx.QueryParam("participation-expires-before"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "participation-expires-before", ctx.QueryParams(), &params.ParticipationExpiresBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participation-expires-before: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := ctx.QueryParam("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "round" -------------
 	if paramValue := ctx.QueryParam("round"); paramValue != "" {
 
@@ -190,12 +289,131 @@ func (w *ServerInterfaceWrapper) LookupAccountByID(ctx echo.Context) error {
 	return err
 }
 
+// LookupAccountAuthHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountAuthHistory(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountAuthHistory(ctx, accountId)
+	return err
+}
+
+// LookupAccountMultisigs converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountMultisigs(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountMultisigs(ctx, accountId)
+	return err
+}
+
+// LookupAccountParticipationHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountParticipationHistory(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountParticipationHistory(ctx, accountId)
+	return err
+}
+
+// LookupAccountRewards converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAccountRewards(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"round": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "account-id" -------------
+	var accountId string
+
+	err = runtime.BindStyledParameter("simple", false, "account-id", ctx.Param("account-id"), &accountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAccountRewardsParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAccountRewards(ctx, accountId, params)
+	return err
+}
+
 // LookupAccountTransactions converts echo context to params.
 func (w *ServerInterfaceWrapper) LookupAccountTransactions(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"limit":                 true,
 		"next":                  true,
+		"order":                 true,
 		"note-prefix":           true,
 		"tx-type":               true,
 		"sig-type":              true,
@@ -249,6 +467,16 @@ func (w *ServerInterfaceWrapper) LookupAccountTransactions(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := ctx.QueryParam("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "note-prefix" -------------
 	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
 
@@ -388,12 +616,20 @@ func (w *ServerInterfaceWrapper) LookupAccountTransactions(ctx echo.Context) err
 func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
-		"limit":    true,
-		"next":     true,
-		"creator":  true,
-		"name":     true,
-		"unit":     true,
-		"asset-id": true,
+		"limit":             true,
+		"next":              true,
+		"creator":           true,
+		"manager":           true,
+		"reserve":           true,
+		"freeze":            true,
+		"clawback":          true,
+		"name":              true,
+		"unit":              true,
+		"query":             true,
+		"match":             true,
+		"order":             true,
+		"asset-id":          true,
+		"include-destroyed": true,
 	}
 
 	// Check for unknown query parameters.
@@ -437,6 +673,46 @@ func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter creator: %s", err))
 	}
 
+	// ------------- Optional query parameter "manager" -------------
+	if paramValue := ctx.QueryParam("manager"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "manager", ctx.QueryParams(), &params.Manager)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter manager: %s", err))
+	}
+
+	// ------------- Optional query parameter "reserve" -------------
+	if paramValue := ctx.QueryParam("reserve"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "reserve", ctx.QueryParams(), &params.Reserve)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reserve: %s", err))
+	}
+
+	// ------------- Optional query parameter "freeze" -------------
+	if paramValue := ctx.QueryParam("freeze"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "freeze", ctx.QueryParams(), &params.Freeze)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter freeze: %s", err))
+	}
+
+	// ------------- Optional query parameter "clawback" -------------
+	if paramValue := ctx.QueryParam("clawback"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "clawback", ctx.QueryParams(), &params.Clawback)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter clawback: %s", err))
+	}
+
 	// ------------- Optional query parameter "name" -------------
 	if paramValue := ctx.QueryParam("name"); paramValue != "" {
 
@@ -457,6 +733,36 @@ func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unit: %s", err))
 	}
 
+	// ------------- Optional query parameter "query" -------------
+	if paramValue := ctx.QueryParam("query"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "match" -------------
+	if paramValue := ctx.QueryParam("match"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "match", ctx.QueryParams(), &params.Match)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter match: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := ctx.QueryParam("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "asset-id" -------------
 	if paramValue := ctx.QueryParam("asset-id"); paramValue != "" {
 
@@ -467,6 +773,16 @@ func (w *ServerInterfaceWrapper) SearchForAssets(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
 	}
 
+	// ------------- Optional query parameter "include-destroyed" -------------
+	if paramValue := ctx.QueryParam("include-destroyed"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include-destroyed", ctx.QueryParams(), &params.IncludeDestroyed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-destroyed: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SearchForAssets(ctx, params)
 	return err
@@ -507,6 +823,8 @@ func (w *ServerInterfaceWrapper) LookupAssetBalances(ctx echo.Context) error {
 		"round":                 true,
 		"currency-greater-than": true,
 		"currency-less-than":    true,
+		"order":                 true,
+		"include-closed":        true,
 	}
 
 	// Check for unknown query parameters.
@@ -577,33 +895,35 @@ func (w *ServerInterfaceWrapper) LookupAssetBalances(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency-less-than: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := ctx.QueryParam("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "include-closed" -------------
+	if paramValue := ctx.QueryParam("include-closed"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "include-closed", ctx.QueryParams(), &params.IncludeClosed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include-closed: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.LookupAssetBalances(ctx, assetId, params)
 	return err
 }
 
-// LookupAssetTransactions converts echo context to params.
-func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error {
+// LookupAssetConfigHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetConfigHistory(ctx echo.Context) error {
 
-	validQueryParams := map[string]bool{
-		"limit":                 true,
-		"next":                  true,
-		"note-prefix":           true,
-		"tx-type":               true,
-		"sig-type":              true,
-		"txid":                  true,
-		"round":                 true,
-		"min-round":             true,
-		"max-round":             true,
-		"before-time":           true,
-		"after-time":            true,
-		"currency-greater-than": true,
-		"currency-less-than":    true,
-		"address":               true,
-		"address-role":          true,
-		"exclude-close-to":      true,
-		"rekey-to":              true,
-	}
+	validQueryParams := map[string]bool{}
 
 	// Check for unknown query parameters.
 	for name, _ := range ctx.QueryParams() {
@@ -621,10 +941,95 @@ func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params LookupAssetTransactionsParams
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAssetConfigHistory(ctx, assetId)
+	return err
+}
+
+// LookupAssetSupply converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetSupply(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"round": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAssetSupplyParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupAssetSupply(ctx, assetId, params)
+	return err
+}
+
+// LookupAssetTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"limit":                 true,
+		"next":                  true,
+		"order":                 true,
+		"note-prefix":           true,
+		"tx-type":               true,
+		"sig-type":              true,
+		"txid":                  true,
+		"round":                 true,
+		"min-round":             true,
+		"max-round":             true,
+		"before-time":           true,
+		"after-time":            true,
+		"currency-greater-than": true,
+		"currency-less-than":    true,
+		"address":               true,
+		"address-role":          true,
+		"exclude-close-to":      true,
+		"rekey-to":              true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAssetTransactionsParams
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
 
 	}
 
@@ -643,6 +1048,16 @@ func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := ctx.QueryParam("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "note-prefix" -------------
 	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
 
@@ -801,7 +1216,9 @@ func (w *ServerInterfaceWrapper) LookupAssetTransactions(ctx echo.Context) error
 // LookupBlock converts echo context to params.
 func (w *ServerInterfaceWrapper) LookupBlock(ctx echo.Context) error {
 
-	validQueryParams := map[string]bool{}
+	validQueryParams := map[string]bool{
+		"transactions": true,
+	}
 
 	// Check for unknown query parameters.
 	for name, _ := range ctx.QueryParams() {
@@ -819,8 +1236,248 @@ func (w *ServerInterfaceWrapper) LookupBlock(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round-number: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupBlockParams
+	// ------------- Optional query parameter "transactions" -------------
+	if paramValue := ctx.QueryParam("transactions"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "transactions", ctx.QueryParams(), &params.Transactions)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter transactions: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupBlock(ctx, roundNumber, params)
+	return err
+}
+
+// LookupGroup converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupGroup(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "group-id" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameter("simple", false, "group-id", ctx.Param("group-id"), &groupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupGroup(ctx, groupId)
+	return err
+}
+
+// LookupStake converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupStake(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupStake(ctx)
+	return err
+}
+
+// LookupStats converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupStats(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"interval":    true,
+		"min-round":   true,
+		"max-round":   true,
+		"after-time":  true,
+		"before-time": true,
+		"limit":       true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupStatsParams
+	// ------------- Optional query parameter "interval" -------------
+	if paramValue := ctx.QueryParam("interval"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "after-time" -------------
+	if paramValue := ctx.QueryParam("after-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "before-time" -------------
+	if paramValue := ctx.QueryParam("before-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupStats(ctx, params)
+	return err
+}
+
+// LookupAssetStats converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupAssetStats(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"interval":    true,
+		"min-round":   true,
+		"max-round":   true,
+		"after-time":  true,
+		"before-time": true,
+		"limit":       true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "asset-id" -------------
+	var assetId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "asset-id", ctx.Param("asset-id"), &assetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupAssetStatsParams
+	// ------------- Optional query parameter "interval" -------------
+	if paramValue := ctx.QueryParam("interval"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// ------------- Optional query parameter "min-round" -------------
+	if paramValue := ctx.QueryParam("min-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "max-round" -------------
+	if paramValue := ctx.QueryParam("max-round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// ------------- Optional query parameter "after-time" -------------
+	if paramValue := ctx.QueryParam("after-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "after-time", ctx.QueryParams(), &params.AfterTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "before-time" -------------
+	if paramValue := ctx.QueryParam("before-time"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "before-time", ctx.QueryParams(), &params.BeforeTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before-time: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := ctx.QueryParam("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LookupBlock(ctx, roundNumber)
+	err = w.Handler.LookupAssetStats(ctx, assetId, params)
 	return err
 }
 
@@ -830,6 +1487,7 @@ func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 	validQueryParams := map[string]bool{
 		"limit":                 true,
 		"next":                  true,
+		"order":                 true,
 		"note-prefix":           true,
 		"tx-type":               true,
 		"sig-type":              true,
@@ -846,6 +1504,9 @@ func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 		"address-role":          true,
 		"exclude-close-to":      true,
 		"rekey-to":              true,
+		"group-id":              true,
+		"lsig-program-hash":     true,
+		"msig-participant":      true,
 	}
 
 	// Check for unknown query parameters.
@@ -879,6 +1540,16 @@ func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter next: %s", err))
 	}
 
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := ctx.QueryParam("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
 	// ------------- Optional query parameter "note-prefix" -------------
 	if paramValue := ctx.QueryParam("note-prefix"); paramValue != "" {
 
@@ -1039,11 +1710,81 @@ func (w *ServerInterfaceWrapper) SearchForTransactions(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rekey-to: %s", err))
 	}
 
+	// ------------- Optional query parameter "group-id" -------------
+	if paramValue := ctx.QueryParam("group-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "group-id", ctx.QueryParams(), &params.GroupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "lsig-program-hash" -------------
+	if paramValue := ctx.QueryParam("lsig-program-hash"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "lsig-program-hash", ctx.QueryParams(), &params.LsigProgramHash)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lsig-program-hash: %s", err))
+	}
+
+	// ------------- Optional query parameter "msig-participant" -------------
+	if paramValue := ctx.QueryParam("msig-participant"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "msig-participant", ctx.QueryParams(), &params.MsigParticipant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter msig-participant: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SearchForTransactions(ctx, params)
 	return err
 }
 
+// LookupTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) LookupTransaction(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"round": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameter("simple", false, "txid", ctx.Param("txid"), &txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupTransactionParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LookupTransaction(ctx, txid, params)
+	return err
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router interface {
 	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -1063,141 +1804,233 @@ func RegisterHandlers(router interface {
 
 	router.GET("/v2/accounts", wrapper.SearchForAccounts, m...)
 	router.GET("/v2/accounts/:account-id", wrapper.LookupAccountByID, m...)
+	router.GET("/v2/accounts/:account-id/auth-history", wrapper.LookupAccountAuthHistory, m...)
+	router.GET("/v2/accounts/:account-id/multisigs", wrapper.LookupAccountMultisigs, m...)
+	router.GET("/v2/accounts/:account-id/participation-history", wrapper.LookupAccountParticipationHistory, m...)
+	router.GET("/v2/accounts/:account-id/rewards", wrapper.LookupAccountRewards, m...)
 	router.GET("/v2/accounts/:account-id/transactions", wrapper.LookupAccountTransactions, m...)
 	router.GET("/v2/assets", wrapper.SearchForAssets, m...)
 	router.GET("/v2/assets/:asset-id", wrapper.LookupAssetByID, m...)
 	router.GET("/v2/assets/:asset-id/balances", wrapper.LookupAssetBalances, m...)
+	router.GET("/v2/assets/:asset-id/config-history", wrapper.LookupAssetConfigHistory, m...)
+	router.GET("/v2/assets/:asset-id/supply", wrapper.LookupAssetSupply, m...)
 	router.GET("/v2/assets/:asset-id/transactions", wrapper.LookupAssetTransactions, m...)
 	router.GET("/v2/blocks/:round-number", wrapper.LookupBlock, m...)
+	router.GET("/v2/groups/:group-id", wrapper.LookupGroup, m...)
+	router.GET("/v2/stake", wrapper.LookupStake, m...)
+	router.GET("/v2/stats", wrapper.LookupStats, m...)
+	router.GET("/v2/stats/assets/:asset-id", wrapper.LookupAssetStats, m...)
 	router.GET("/v2/transactions", wrapper.SearchForTransactions, m...)
+	router.GET("/v2/transactions/:txid", wrapper.LookupTransaction, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/2/cNvLov0LsO6DJvV07Ta8HNMDhkCYXNLikFyRuD3hxHo4rze6ylkgdSXm97cf/",
-	"+wecISVKorRrx3XSdn9KvOKX4XA43zn8ZZapslISpDWzJ7/MKq55CRY0/sWzTNXSLkTu/srBZFpUVig5",
-	"exK+MWO1kOvZfCbcrxW3m9l8JnkJbRvXfz7T8N9aaMhnT6yuYT4z2QZK7ga2u8q1bka6WqzVwg/xlIZ4",
-	"+Xx2PfGB57kGY4ZQ/ksWOyZkVtQ5MKu5NDxznwzbCrthdiMM852ZkExJYGrF7KbTmK0EFLk5CYv8bw16",
-	"F63STz69JF6sleYyX6yULrl1K/D9rvd+9jMstCpguMZnqlwKCWFF0Cyo2UxmFcthhY023DIHnVtnaGgV",
-	"M8B1tmErpfcsk4CI1wqyLmdP3s8MyBw07nQG4hL/u9IAP8PCcr0GO/sw7yHm2i1uZUEvrCgTS3vpd06D",
-	"qQtrGLbFNa7FJUjmep2w17WxbAmMS/b2xTP21VdffcMIjRZyT6Cjq2pnj9fU7ELOLYTPh2zq2xfPcP53",
-	"foGHtuLGQPqgPXVf2MvnYwsIHRPkJ6SFNe5D5+S4HokD1f7Ma7tZuP0e3xF/tA3LlFyJda0hd2RUG6BD",
-	"ZSqQuZBrdgG7Udw30/x6R2cJK6XhQPKixndKX/H8n5TAslprkNlusdbAkeY3XA5R8tajwmxUXeRswy9x",
-	"3bxEZu/7MteX9vmSF7VDkci0elqslWHcYzCHFa8Ly8LErJaFYzZuNE+zTBhWaXUpcsjnjv9uNyLbsIwb",
-	"GgLbsa0oCof+2kA+hub06iaOxHWMEgfXrfCBC/p8kdGuaw8m4AoPwiIrlIGFVXuETJAbXOYsFgutxDE3",
-	"EznsbAMMJ3cfSNwi7qQj6KLYMYv7mjNuGGdBwMyZWLGdqtkWN6cQF9jfr8ZhrWQOabg5HWnoVJAx9A2Q",
-	"kUDeUqkCuETkFaIUdoix1/xKlHXJZF0uQbu1BzZjFdNgay3HIKAR9+xZya8WWtUyP0BsWqZ0zN1MBZlY",
-	"CchZM8oYLO00++AR8mbwtMI8AicMMgpOM8secCRcJTbF0Zn7wiq+hmhPTtgP/pjhV6suQDankS13+KnS",
-	"cClUbZpOIzDi1OMiDaFTFhaVhpW4GgL5zqPDkTq18byg9IIoU9JyISF3bAKBVhbo2IzCFE14U2m75Ab+",
-	"+pcxUdN+1XABuyT36BMALafRyzfuC/WdXkUzw54jeSAdrlSf/iZp7yC6w0YLOvQJceK+epaQtpk6/Q+w",
-	"muK5jVgv6OcBSYn1mePAK1Egd/7JUVJAQ22cotZFRODXRqwlt7WGJ+fyz+4vtmDvLJc517n7paSfXteF",
-	"Fe/E2v1U0E+v1Fpk78R6BJkNrElTAruV9I8bL2062KtmuakpwufUDBV3DS9gp8HNwbMV/nO1Qqzzlf55",
-	"bMqUjv5KqYu6ilGYdQzI5Y69fD5GVjjkofbw2dVQc6ff8ACaSkkDaAh7A/mt/8395PgGSGSLvKoKkXEH",
-	"3elPRqHm00JQaVWBtgJiN4D77580rGZPZv/ntHUbnFI3c+onbJVNOyYP6BRw6/kAnX/PGUA7/lZWtSUF",
-	"J3XEmjPxvoGtP2e7eWr5E2R2du16dsF4AGVldw8dwB52c3fYwv8LC6W5Ad48yFxrvvuV8UgScoGSbjjy",
-	"DwZyZI8VXwuJC5+z7QYkK/mF4xZcKrsBzdxegLFBVpKqReKz8Ud4gevVr5NZ6lwl9tR89KY6jflbXnCZ",
-	"wV3s7NIPdfDOvhZSIBDfqSL3dthxi90WN6i8iy2+i0Prxtl7ULHR/bI3nPIukGTuCks3YGwBX0eab/by",
-	"oyn+20JlF7fay6mtwlH3zPwd8MJunm3gV5g/GnsPFGeRE/8OSPoPQorzWRz8OPgER8genuMeeXcx2Zvw",
-	"RkR+HZThWI9NOMZ9BEpIskWdos0t4953S6bcuTyXz2ElpHDfn5zLnFt+uuRGZOa0NqC9fnCyVuwJ80M+",
-	"55afy9m8z/7GokzonvPQVPWyEBm7gF1qF8hvOBzh/Py9s6zPzz8wqywvIq9R5E301n6r8w5JjiZYOMpQ",
-	"tV14L/xCw5brPAG6aXwNODK5NadmnTM/NrlEvJffj58+Bq3YGC7afXKrpjZsA4V3twgTZsQ9/F5Z7yjg",
-	"W0Y0xGoDhv2n5NV7Ie0HtjivHz36Cliscf3H2/juyOwqcvkdLLomtLaJCMn5+XsMfuBeRtE4vuZCmsBJ",
-	"nF3tEOf9yktgmWN+kJ+wlyuGJ2He6e7Dkv6UNeQmDLmC2ZlbIzpPWMYluoirHF2mQjIud3171IC1wex/",
-	"CxewO4t8LzeMwWTknF1MbXTFtcNI5CFWq7Drvv/oxj9pdj4se2rrP2rPU5tdcW1FJipOyznImnvT6eMG",
-	"2XcSk2dPrfpHjI5jhKTkkaPGiyU3kNwOcF/cfjjiYRy94zbQWJiJhBuu4IRhJN1L1mWBfvYmBkgkzTUG",
-	"AMKyKSY2BlqaSkDLlgUGMLoYiXnthpsQC8CQSVbUiKqDuNKIxD9zCECp76goiH1hOmJGuHkLuORj+B/3",
-	"xL2UudNNwHTjIo2fLZzo/mGYN95fSlII/rjghAuet9n8Rl60+cxYbuv0dihZuO3IoYA1LZwaB0LxoH1h",
-	"og1ycPxrtSqEBLZgolmtxdVSHEtlgoI5LRPzc4CT2H9mjtrcAAePkCLjCOxKqYIGZt+r+GzK9U2AlCBQ",
-	"JeNhbKWZVNHfcIAV0GSLeF1gr8we8o72EM1bpzRt41DRapxbb/psLKlOdVoxarL06kHEvFMk6lhT5vRx",
-	"aWqMZVqVqeJkoEcZKADl0KLDWRdOZ0qKU0AyfBe6RToWeyBWTro9DIKx2DENa2EsaK9fI4SNX78NW+ws",
-	"OMi4taDdRP//wd+fvH+6+H988fOjxTf/9/TDL3+5fvjnwY+Pr//2t//p/vTV9d8e/v1PKXXvUllYrIQ2",
-	"dnHJi5Tn+Pz8vWv0wqAW9MI1TbOfDqoYBZvFiN2B017AbpGLok7vtp/3n8/dtN83yqaplxewQyEDPNuw",
-	"JbfZBqVQZ3rXZmLqgu9d8Cta8Ct+Z+s9jJZcUzexVsr25viNUFWPn0wdpgQBpohjuGujKE2yl+A7G4ti",
-	"LpU3U2sp/lsDEzlI6z5pzCDocRaH3ZAWMWAdQuaQiJj6gSlFoh0+SSw41WHK4Btq2kc5AdGMNIqTYD8M",
-	"wH3ecNWw0MbwcT9E2u8NTNd4xoHlOmF2utPQWpu1FN4MSxy1tCFQC2kpALw/iS3I5g0BOjJHMikNjQSV",
-	"MLiehpQSJ7yDKUFyyfX2ppEwHXNquwGfC9EjvbZjsKNW7pTPKdmlMCoxTC23XFJ2iutHOPS9DZBgdL22",
-	"yrH3jBtIumiEWay0+hnS7HrlNmq7AdRAUPGwMSrdAql3NHYnJh4rIY3q0WYPBvzGcIyS9pvmECX2mT6y",
-	"rmth5IQjlUf2IXrAghbHJZH1M8wxjC3YkcMR+5xOafz2cHiY+2cjK/h2ybOLJNIzB9PT1gTv6JtWsdA5",
-	"7IJXjVvai6z5pq3TJJ30AV0K23UetsQwSu5nEfn95kk+h0yUvEibHzliH1N2Gv6Ui7WgFKraQJRC5Adi",
-	"lRLSEhXlwlQF35GTo0XNyxV7NI8y6vxu5OJSGLEsAFt8SS2clYxrayye0MUtD6TdGGz++IDmm1rmGnK7",
-	"MYRYo5izq8+axMHGwFuC3QJI9gjbffkNe4CmrRGX8NBhsaTMstmTL7/BtCv641FK2Pm8wym+kiNj+bdn",
-	"LGk6RtuexnBCyo+aYjQh5XuchU2cJup6yFnClp7r7T9LJZd8DWlfXbkHJuqLu4macQ8vMqdMR2O12jFh",
-	"0/OD5Y4/LTbcbNJSmMBgmSpLYUt3gKxiRpWOntqsHJo0DEdpkySHG7jCR/QjVJjr6AixDYncvxVEsjy1",
-	"avT2fM9L6KJ17kx5UzuY2+w7zxCTCNZgQF+mJ9EjGxzkpu/LHkglF6U7O/lDz8+69JeMqijLi+S0NvCu",
-	"vj9/euhDVS03ymIUsXUHsTziSbdGca3T6+S1m+qHt6+8YCiVhm5IZhmCBR0Ro8FqAZfJE9uPLDWaSSMu",
-	"AuZTCgrFMgew4s8xZGMKtlIXFwCVkOvTpetDKgSN2lce1iDBCDN+sNcbhx732R3FyL7FodkSCiXX5v7P",
-	"ZAB8xERfA1LQy+f7oB4MHJJkF9h0HDGunZviTUiqpaFd+/vHRuST3hslf+vbjruQHdOh+M8zH60hD4eS",
-	"Q1RuuXHMGWRO4gaP4YYLOeJXBshHfGSAM75T2gpy1QJ8Ao+XFSUYy8sqzRSNg5FOIp5qB2jTxWlJBjIl",
-	"c8OMkBkwqJTZJBHRD2cPp7qSOFkhDLG++PJfpjTlSqIEsKoXND40YjQZHu/CuNBK2TFAUVTEeQ1KWcZr",
-	"uwFpG8804FWG/koc7XCNmhAp3MSy2GvHhkMuKi+K3ZwJ+wWNg74vlAsl6IsCmNUAbLtRBlgB/BLaeys4",
-	"2heGnV2J3OCtlAKuRKbWmlcbkTGlc9An7IVPpEbtjDr5+R6dMB+69Z71syuJy8sVkOoWr5OWGUIhxp2Y",
-	"3l1MM2dKFrvBz3jZw0BxCeaEnW0VAWHaFAnjhGGnx7JGK4WzXKxWgOcUl4NKHfZrP0Qw4Q0cvAfUDOvX",
-	"9AlO25VcoDYzotxasqCu5DNqxHzAznZsqt7RKEmTDgRVQL4G7VRuVRLaRQltSozTIZS2rSG5AopjOc4m",
-	"pNUqrzOgRIx3HXqMwBIDkJqbGFHUGmkoXIBq4QxGYOCpzlBAo+sR2YFSdVeIeweXoNnSWVntQA+I6URw",
-	"Gcs1BqYBY/W0VMgfpplzXa01z2FhLLdwkCz5gXq8ww7RCJfqZgP86Nr31aaObtKR+GkpHcWSnJSJeXmK",
-	"l42qXm/HArwv6F6XhoIib3gPCtvOB4rVCmBhhEx7ZVYAyNt5lkHlyDm+qw3gGBXpmcgqMBsjyFa3w9KK",
-	"S6CY4IQysMh4kdUF+b4nJP0244XuOlELWFnlCCy+Cdi6KoSba4m+d7qCRPNpxwCjHu5EOTLd+RakxYcb",
-	"P+5wNNJqNMq+KOAS0oo7cAq2f6e2zsjdNXvhpmjBmNN5waPSQE66CkaIaLd/8AZGBD4dJk9100C6rRhB",
-	"bh7vcwVaqFxkTMifwJ/mhi0FikH2nSlphazx6qCGFm6SEwzzBvq5AUMK0P4cD+HiFEVqA2cStp3dziN9",
-	"rhtmMpZfAIEdMhy8aDx0TzUYkdcjLhbNsy5kNyNGf3jfcgunutlac0d02eNQzSGfOnR9Wu6RTW+3hlga",
-	"5VMd5nsIs+JNTJt5Rj2MbYcsyNByxPZRVgX/QMjoasa+BG28n2boSoGrPWO7Fp3xKTdUq0oZyG8xy4JX",
-	"lVaXY05a13ZH7LiluaB8UV4Q9gcf6EtgcCRxtgHAbIXNNouRMLZrSy0cDG/7ltZwSlIh8BTCagWZPQQG",
-	"jIfSDdpRKOizg+I58BwTWNrQNgW1+6A8+F4xN7SJ9BppBGqhrVqDozw84EANqG8f8f+oDqT9S4X/w9DN",
-	"AccgKDJ+79NOKmrjiafNi+JsBwax0lzQjM5IpQwv0p7nMGkOBd9NTYkNupM2im1wvpPM4U6GOYECV5DV",
-	"tntgEqqfP2dTk7sm/QU3x3N4KuK7h/2d/IfWSsdJ8L1gnGTgWrBwL5CsGoXfecHQTdzkfHY30H2L7iK2",
-	"c5ZgDF9D+lpzTIuhYYoE43T/Idhsg58pI7YB/r5hHFzeSgBqRFkV5Jr3/NRxv7gXm8oin0gPv6E7uBM6",
-	"3hf8HWagTUd8YzdHojAE3kU3jBdFqNzgTCpnryn8seuvkDlDf7vBUg6SgbyEQlWQbI35uweEep15Drm9",
-	"kuSrfYd/nl3JVNs4ioyto+Wlkv4xQk5Vbha2i4kDfUNRDLvNkgiVkW4/4gsKtDUj4lAr0B8z5pkf44Ac",
-	"97XUQcUIoeEQM3Pb0ffQtDHpOtx8wdv8bYYlRnVDLmbrMKKyUs0cucjRbZSc4xap61jkYyonWaM+3ajq",
-	"PmKCsX/qWnLhIFy0GdFpGeHaC7leTCTjZJiN4xuG2xgoBJOLjAd35KVLyKdvMqFDpF/ebMt9GSfXf2T4",
-	"OMN/MZKFFdWtCGlN2JI9ePn8IcOUulDFhrdJ/20htr2LXAGM+QN6LlRnDY6MsScbc3XZJmJiq74OtxfK",
-	"A2NC33GDmZW+ufddfaaBoA6QvpbBcCit6rTff61R5fkWS4UwkJmisioWGHrLyRttNvzrLx+fPv76rywX",
-	"azD2hP0b04E0+JSSXp52dzeYaPO/eecDAtbkGlKyhXc5RnNu/IYMXMvCux5xmPvfodTOxKSOpeGGvaTV",
-	"nJjBQq1WyRTNf+HvTEjvqNCBR2gYYvcALkHlNG4pf/5JtTiu57M96cPFZZM5fLsDWsDYtZjiKkGmXz1e",
-	"tJR6wl653gzkSukMDCtrW/OCak4FBTCmHkpbse3tLMxYkT+DVpg0JJlyNkafJ4sI2ejO5BlqcMb75B0M",
-	"TaJrE8B/8A6F5ZyAfMgqLnSqoGUtrSjwV4fGHyMsVo5BO6D/vRFFggoq5b6bGI45k4rRXdW4JQWf2vQr",
-	"gtlH/zuEdM/ZKGrEqSd9arjTqzCrBg2k+wew4rvSGfG3O0ZvqDf5C6lM2rR6o0fUm9B730WzsZpPbmz3",
-	"scnq9OWe6EKiP7rRGufxlSKDYUFU+tqSd/4+Y6swECd3fH1VY8wpCtOFZDoyDdo44AXsmAYvUuI7LKQT",
-	"3kKFJB6brjB5JkpolS6Snim5JQ7ir77CalIdp4QDOv9fTCynGWaaKswIVQRtfIomml24Adm+a/p06zoN",
-	"Q5q7Crruk849um68EA2OE/a8ieO6Zj4C2AZ3fXlfZ2cSK8RWlKXZJM0KHdclNFZpyPEO3vn5+4q8SYmD",
-	"6xuQYHRthiLSN+HZat1chE7Ym6HZ1Qp02y5l84WWK/1z2zBhbs4/qhhW+lj4nVvgyAl3/6yrgKNy0xH4",
-	"87aMcEtGLUXscU/ElvaYVxNdmJS8WRQq85UXNCxCJVv/i9t9zOus24zzc/mUOcntOU8zFJbvbP15lOfm",
-	"81tOEp2aJGwz6Naf8oZJ7rT4sytJqx1xZ4xoVldc5D7tq5PATOHvqNKv0j7VQ6z8Osdu+97uts3ePX4x",
-	"kmQc73EwNn1W8UfeHqAZJxA7VhzC2ZQ8z3tZqPE1Zsq8aXLFCds+2xqJhW9HEpsnd3M1uZsT43eCINvA",
-	"OibuCQdWQ+GmbcA49TjkOkrrkWzvowynPuTwNw6sg0gjsM+PJY4w6wR5TNzA4iUmCz9tCgB44FQD3wnz",
-	"LMS7hMLvOgjlYhW4WbCOg/+ld1GbakGxkld3er9rL/OIIB73jsGob6wNLfqSEWG8KGvSl+ttjlXvOvi0",
-	"Wbhv6eOVkVF2u6/9gBKPs57bOh8aSoyGNokLqc3xV0Qah1p7d4f8jegepIuD4bJEO0OMa8ZeupF5seU7",
-	"E5TulrDGhwtYpdzrhMIXp0v4ev9J3OgM7fW3kIlKYOmSLhdsaHxcVR0pHUMqr2M6FMcVl423OK8xJ5K3",
-	"l666Nnkwyf31ER4J6LlHMy+65iwNHMwK1+ZZGDusqNnSSJ4dUBEgcRmvQekenuedJpPMzuucN+Vx1IuY",
-	"HE0zzt1k//rxiIEtXSO3aa+5vujIQG66tUPohmNn1I6KEYV9b1FOwJulb9ob3xiUaIzEH0GTX+Utl7kq",
-	"2YtaEhU8+PHti4e+BFggspBA6IjPQ/IZVxpYDSsNJO7bO5TcVY2Bi/wT1RgoBjUGbr/Sw6sLBNoaqy2A",
-	"lCakd0SshbHa58HHHOr+iwpMsZngVJrmM97+vSmj8d2I0/iZbqdIkR41UjrNNncseiLyo9SRTmUibqkq",
-	"oPH391q1pBslbG/SyibYF4c690URu+OlI4lBI8FJ8MJXosyN8YWSmsec2mpkBeaj0A3gIlITVrXMTQ+F",
-	"tFYx7XWa1BK8khDaTDqwxsTnoTLzXeye6kKC7h+fptkUZIpqAaGAwFuZdP8Si2JFj0ZF/scWleEZgWEC",
-	"S6HWIjPkq7ipn+xV6Hs9n5V1YcUtx3kd+pLjLi0xBbqmQt13Bvnjr7/+8pt2uZ8ZuxoiKRns8ct6o9Va",
-	"85LbXtH2dnUHMLGwlSdrNWRZej1iN2sEAKFkXK9rx/rMnC07AajOdaThFZDepSMEJL3eaLHBLb7cMR6R",
-	"unIKbmFF+9Pc/bbhZtOyzm5RQi458/yqHzg7+8fTV4763YT3rxGFQ7H4KHd073iMMY72kHwOZyNmj0QP",
-	"h7LE1xEnGV6V90skB6Wjl3CHGnFdFeB0u5YHDs9NpneVVadha0jkhznprYheqbBovDTWsQHesVVOE6m4",
-	"oNKSkcaFpnQL1S1u9w3w8y6GK3X1b6PBOIjSMYyNPj//kFY2KY0wrV2mO13fcG/f9XDaxTjhbVTDrS4I",
-	"iPs9y3to4P5Buk7WMxZypUItap6h3hjqGHnX0sxfpJ9trK3Mk9PT7XZ7EvxOJ5kqT9eYX7Wwqs42p2Gg",
-	"Qa3kMJ6/nee4cLGzIjPs6ZuXqDMJWwCVm4Qr9G81lDV7fPLIjagqkLwSsyezr04enXxJGNsgEZxSBu7s",
-	"yS/X89np5ePT+FGLdbLaV/OYVxPCdWA4ykJ96mXeNHqh9NP2hYf4xdH3n/7tw3t+xeszfZ/qD/wq4B/y",
-	"AcDf+Cuft33nC++tV6BxSJmh19fgHfPei4El3zmc58LwZQF4vwitwE7I2HzEu2Efeo83PX706PgE0W/+",
-	"CSKHTu5sv/czeuxy9uG6J1BPf2kfyb4ela7+fTE+fPFgKGSprd/Rb3coPCeF7Of0kvf9v9j3q5y83+9T",
-	"aRFJF0hoe0j6tF+o5RD67nvCJwg8Lpuyj9CPutxv+HXRe3vi8Q/xdObn+IDlJ2D+88/gpeLP4fHmz8/u",
-	"/gM9zb+XABrqu6uV44if38KPXoY/lpfht2R4/Lovlt+tEXJ80/CzfNNw1G5qXk3b61nHllN+9fDE6NEO",
-	"2qvyvkB9ntR5n1Tc0B4J2ib3tU1FSfLBpjzxnc7uRh9dLe+J7juYr5ZUvTw1n/t2s/nuXYO8Yz/O8aXj",
-	"T/zS8ZTvFIc//SUQ0X6/qb8Lsd9rii/GJ32mKd0kztfeo5n8ag7H3+/D5XtFZkQBp/Hb/FOkgLfWfUno",
-	"6P1Khekj8csOkxTSvl5/FLR7RcEncOMcLcnfuyX5mbLjmA0dpDkMqswdlQgvQZYtk70vIXKTWFWnQlDn",
-	"RbMpyXEMVx3DVcdw1TFcdQxX3WW46hgsOgaLjir+7ztYhFftmvhLrHy19cDCtSQh40t5nddBqNTTGKk3",
-	"dUjuKVH1mSqXQkKrJIcVtKmn+LrkChvFda6aF2AVM02AYM+6FloVI1I41Fxq7lCGdzwXlmunBh8ilTur",
-	"CQDiDdJo/vjx2RutjR5LKJTBUtFRLS7p8FwUO2Z9RVnudMWwkjkTK7ZTNdviYSnEBfbHeo0UySvxYa1e",
-	"xi/WyKhHHfC++6IpCzIZ3rtLe/UYqTxGKj9VpJKe9Tj9hSoekl261/ne1DVOGcXNq5pThjCRAU2XzhKI",
-	"Abpf98/eF7pui+uDfBFRbHg6Z7aJEB8dEEcHxNEBcXRAHB0Qx3zZowvk6AI5ukCOLpCjC+ToArkHF8jR",
-	"bXF0W/x6bov57Gva0+53fABxJJsQa4fqy2D8dguzwBUvqwKwJgtml/r+TUkXb7A7yg52Bo18/eH6fwMA",
-	"AP//vNEhi1e5AAA=",
+	"H4sIAAAAAAAC/+x9e28cN7LvVyHmHiD23mnJiXcPEAOLA8de3xjH2RiWvAe4cS4ONc2ZYdRDzpJsSRNf",
+	"f/eDqiLZ7B72Y/SKstE/iTXNR7FYLBaLP1Z9ni30ZquVUM7OXnyebbnhG+GEwb/4YqFr5QpZwl+lsAsj",
+	"t05qNXsRvjHrjFSr2Xwm4dctd+vZfKb4RsxepPXnMyP+WUsjytkLZ2oxn9nFWmw4NOx2WygdW7oqVrrw",
+	"TbykJt6+nn0Z+MDL0ghr96n8UVU7JtWiqkvBnOHK8gV8suxSujVza2mZr8ykYloJppfMrVuF2VKKqrRH",
+	"YZD/rIXZJaP0nQ8PiVcrbbgqi6U2G+5gBL7el9HPvofC6Ersj/GV3pxJJcKIRBxQnEzmNCvFEgutuWNA",
+	"HYwzFHSaWcHNYs2W2hyxU2jDWuGKPzHo0jJuRNqysMwKx852jCsqyRZaLeUq5dqccVUyI87FrnCaSYst",
+	"KHHJeO3W2shfpVpFEoAaKjzCZmJCymuh6s3sxU8zK1QpDEraQsgL/OfSCPGrKBw3K+Fm8xkNa8MVXwkT",
+	"/zbCCnMh4t9UK/65qPjlGV+cY9M0nNnP884cf4F5WjphCic3mVl664XQCFtXzjIsiyxZyQuhGNQ6Yj/U",
+	"1rEzAXz98OYVe/78+beMJMKJ0q+1XgY1vafsiQJVcifC5yny+eHNK+z/xA9wailiWk5nvIQv7O3rvgGE",
+	"ipmVJJUTNGUtJQA1Mrqh+RlkrQDR6Z8Rr6Wsl+HaiBJWRG0F6Qe7FaoEWR0SztjN3WmBM7HURkwULyp8",
+	"q/KV9v+bCtiiNkaoxa5YGcFR5tdc7bPkg2eFXeu6KtmaX+C4+Qb3LV+XQV2a5wte1cAiuTD6ZbXSjdor",
+	"xZLXlWOhY1arSlgbtV8hSyYt2xp9IUtRzplU7HItF2u24NZrTijHLmVVAftrK8o+NudHN7AkvqQsAbqu",
+	"xQ8c0MNlRjOuEU6IC2GKQ1a9gx0RmQFV2ZkQijYiUgOTVUCn47vTA+IKR1AsKm0F7EXDFkHYYWE3TvfQ",
+	"xjyw17APsHP4QLYRSocCYqtqxxxKbsm4xU2dduM5k0u20zW7RPGr5DnW96MBudigVYEMb5kuYC/2cr3L",
+	"jAzfz7SuBFcoHiuj6212c+pqUOlXQmoIYu05E2qhSxrfGbfi3/9MhQWzjquSm5Jpwz5+eFdYvhSMV9s1",
+	"PxOubwiRpENFhvqefRn7KlXCo4GR0/dmZaDE4FJd6wrE3y+WS2FiYV27uV/eIAL2XG63ogTT0OuJvlF3",
+	"iBqZtlC6FNYZvRsaBVKcUhrr3IzQputRWp0wF7zaJ/GdUCu3jicM7XhlX3xSYGXXqmQFnj/w3/DjWteG",
+	"FaBX4V+oRz+evoIvJd+xgj3xhD9lHH4JBXqH4anKmc7Y52w+g45m81nJd3kDt5Ib6fbH9QO/kpt6w1S9",
+	"ORMGxhcWkdPMCFcb1cddanFEp1dWroqt0SvDN8Wa2/X46rVypWh6Oav0Si7gl+TQ51tj0Nq1FzRqwrSl",
+	"cMqxfCPY82/Y2c4Jyzj9mB51FAPq9WVYbKBYmWta62XXHifuSmls+FVBYjF+lHHAnsTitFuxkEspShZb",
+	"6RtQ082IDGykOoye5oCVkCPVCDlSTSQHJ4IbJxdyy5U7TCI3deUkCKQ3PqwXyvqskgswMkBmvDNCOsuS",
+	"jqyXuqaotN7E5xYLW6lWlcD+uKtNFLzeMXeHcnemixJXGU6dol/gyrEtX4lEexyxj95gxK9OnwsV7Upg",
+	"JC0YcSF1bWOlnlFi1/0jgzlV2olia8RSXu0TeeKFyDLOqIzfTTb+SLXQynEJU+x1BzRH5lEvTUmHd7WO",
+	"tSlFxgz+EX5ONfXZzu9CsOtvtZVQMAzlrNKLc9ypuF3APlXBbsiW0lg3b50K/GFgKSsngHqUdxIAqA5E",
+	"sAK8QD31L9dC9dT+pEBQcDwg8+di6yKro3zMmdVMOqbB8aeEKHEPOgunYE3lsWMGHklhezd+4lxuu+R2",
+	"AbuksIv8Nhm9RKNKgUQoOijXonGYDUpO7GHEFpmoMZe6qykHteQkDYmFCjIJModR+OoNhrzzuFV/gvs4",
+	"7Rs0Gv28t4zl6hRONyRilv1SWxfZUIPq7DAinIWiOsVlALq7YCfeOoBfNvTTD6DZT+QKfqrop3dgfpzI",
+	"VQ8zI61ZnyZW29D/oL28wLmrONxcF+6qv4cth4LnYmcE9MEXS/zf1ZL8o0vza1+XuUPUO63P623KwkXr",
+	"AHW2Y29f94kVNjn1YuD0at/vR7/hArRbrazAGwF/U/DB/wY/ga4WtGnz7baSCw7UHf9iNfpNGgq2Rm+F",
+	"cVKk9yHwz38zYjl7Mftfx839yTFVs8e+w8ZV5fosF1oF3Hk9QOvfawY8ZOnNtnbkHsktsbgmfoq0dfts",
+	"Jk+f/SIWbvYFarbJeCI2W7d7CgRHZl1yU9pb4NkdcgAYgGROnBA/qD3WtUlsWr0e6+ztCRr+WzqxsQeI",
+	"nCeZG8N3dyyCZNAVuPHut/zRihJ3li1fScXpTgj39w0/x7sfpd1amLAPB9POu12g0eZOy9uH3it0NMtv",
+	"u3vLwd54PVgr3He84mohbmNmz3xTk2f2B6kkEvE9+WAepzhMcWTlbUzxK7z7+V5ap83uNtQetlesqcHp",
+	"67ih5dWaq5W45+nuasb2KG6D0behHaGdSZy85y0Yu7wNJp04fjv7SO9VbK3kP+twEyNLoRyYuyarAe5U",
+	"vVgY6mHrA7mzvzBys0Fu9e4GT31ea2Lq7bbaPXSzyCKV03hJRcdMIt/idVh2a3J8oJQ8bpStlXDzbbJ2",
+	"69vbH/Gi9ODdsXbrh7Attoi/IVu/A9fatRg6xClsdaTn7wWvgJ/iDvpP2h6h4gfvCr/F09OdrvLguj/A",
+	"gm+PcHTj6urepscDRet98OnDp1s0be+SvduU5oMVRGvEfZpihN15Cg5k/Ynj5+Kh81ppVySjVatio5XY",
+	"Ze53GxTQWlR0h9WCz5SaKe2aayon8j3q5bKSShzQj68R++tpVx3arBptdURM+pjXHWSHuMPl6OGrxMNM",
+	"+GnW+62Y7KeN5/mh8zBxko8xMBnVKNvSZq/PvAcvgYKbSgrb2/qPdF+JX9EM970kQ0RUEL/gsuJnlThi",
+	"6fAbbIO0vhEEDHqs4EZfNPfRiCQ3X1lmBLBJasW2upKL3RH7u3aIcEO7n1fVfv9wp/nQDxctoZq+7FtS",
+	"e+Dib3V4kBh/CddJ6U1QBphOH5hUdIMOs8Yd4/7WmC5D4Qb6tVhKhVfjLz6pkjt+fMatXNjj2grj3cRH",
+	"K81eMN/ka+74JzWbd48/fQ9W3DruSgnGIzcLhNvdb+HTp58AD/Dp08+EMEtQWclG6C/Om1ujfZGjDgqQ",
+	"DF27wkNgi+TOpd2xjQgJbBlrD/Y6Z75t/NG3z3z7+WXQOAL2Bw2fYNRUJm719MaHesQ5/Lt2/qqdXzKS",
+	"IVZbYdl/b/j2J6ncz6z4VD979lyw1PH+3/6WHJbMbkuA1MnOiAHn/QBW+dOnnxB5jHOZIMj4iktlg660",
+	"cqWAcR7XDVAUOHeJ8oi9XTJcCfMuAA3+9Kssipu0BMVmpzBGhB+wBVfQYL0tEdArFeNq173RtcK5cHH+",
+	"AYAJpwl64UAAEcFBCz6yUZQ1NBc3i2aG2SW3rOLWeYBqXowWBFAuhsRpyw3wPUFJA3APKzBfv1e8XkT5",
+	"CmQNCdiNJCvrCQnDuxEXCSSjt0KJcs608f8k6fPwujMBDXhWxw1OLlmtzpW+VHn2l6ISLofh/a+1wI1K",
+	"GzxPtEiSNkhstduf3Ah6mc9g9guQzgtx3eGjAFlcHKmw50eTYjLz4LYGgOqL5TGgTwIUBJEjT+cpIJTg",
+	"pXrpqfU401AzQfg1ELCdE3icdU4YoOT/PfmPFz+9LP4vL359Vnz7v49//vznL0//tPfjN1/++tf/3/7p",
+	"+Ze/Pv2Pf8ttQq3D8sRL6tYhHRsZ21mye4ledreMCNkPy3EAM1AAQi678AV8gZVfW0IEwxiDzgw9kTmK",
+	"Izhi+MjU28JnFb5qiMfjBnHeOiyOwhn2yTKq2dIDGW2OpBKx5ja8vMAnOID3AlZN2mV7Vg2I8p4JDfxO",
+	"zSYJ/Vbigvfxvx+b9VaVcJoQtv0KpQGy+h2qq3bnEYNJkNmA0AqwrIDFms0PwlXRobPOT4dWFUwHaLIV",
+	"DZwKB0HxpH1lkwkCOn70/oyCyThadKFgJWv1QtLTmUbr+T4EWKB/YiBt0MDkFnJinJC91bqihkF9v0+F",
+	"9BAilZCouXloG1V48reYcE8RH1J723bUBt3XHc0ims9S30GdOzhEzM77rhrLHg9apRgVOfPmbmIm5ESU",
+	"ScUWWlmhbI2PD5xe6Opo71xgRSVwqynabshzscubhwLF8CRUS3HhTyRsMrunybZpxEpaJ4w/Lzbn2fvf",
+	"OS60EwWaGMUFr3JX1J8+/QSF3li06t9IkzvB+8diybzQ40XZY/lht4CdLWVV52fb9/ufr6Hbv8fDk63P",
+	"zsUONxnBF2t2xt0Cd+R291BmoGs0ToYH/I4G/I7f2ninyRIUhY6N1q7Tx+9Eqjr6ZGgxZQQwJxz7s9bL",
+	"0gH18qFvXz9NTAoyCaNF5xj3TGTvO2YON6FWUSvpLL7tJjUkFdj+VrCl0RuWmDr0EgvrF5W4EBWFZ6Bn",
+	"m44JbsLjlJZjP3zfM1467owDXQVxT2QexBU9AZPsk4URpYQDzgSDqW0xRvvNt0AvfEUkw0r4b2pIwUnI",
+	"n6fyxEwfbIuCwPHaIYN3wvURdUMzNnSIs05uwfATelbpsHyNrkH2Bke7RZcjlGVQNnh/9vfBaF0HpTHY",
+	"p82dG3Ul0r6iq+la8tU7laep9T1vuIZvZqjlwRZp6eWe5qdzNIEbhxjowoqW6jgE6DBmfu2txH6LjES1",
+	"LT2deW0Zby2OhSFnlWyAA/Y92DrT3rftQW8N3A0nrmO+AetCLIM9RXcDzwrFPEi0yR25S7CflrMkvlz2",
+	"TwdhrE08B1z/WgnLJBwbe5wqsY0bDb2hJDtQqUpxdTOsIg1ukufsPRXtyjwREVvqlbgWSnf/yBCjyZAF",
+	"5Xd3m8RNWixXXedSW9gGHrvvzXw7YFWs2YTbOGLvm3lX6U2UdKyUPdN+DXbOZ4PiEc/H+6Pfn00f0ml/",
+	"bePvfW2R4wyXmUYWwZ8+5hPzp1gMh3O4ezr/6untlGH1GKlpWIF4YA2RrLC7XgkMlwp79LyOR9MocxG3",
+	"oVrO6gPus9Ie8/Zf3o8ONkhzBUWb87p9OZAGd9pnfy2Vo7es45Glwjz4gBU9fWQjRXl5ybQdoqCAQRw8",
+	"/41i8/clsv3I/3ItvH7tbC1NxXC5soRZ9+Z4ZXWmmVpdckUBVaAe8dDXRi+1N3YutbEOw+xk722n7yNt",
+	"P2LX7c7oyi4yIKs5pC2WRv8q8gfsJUjF5X63ft6Am1Q737jewiYs1aGbUTMubIFJ5XQyEn/LEW66knsO",
+	"GDg0pGs3fd8mKsFwujaZyU1WP9eHrbfZvAWF91KeTlCvgnkftX9mtdFH1r717bGjUNckl2q47wSHJFcs",
+	"2VBb2jOvopIS9pjab1SUp3nPcAsx/HLSuACaXibhOVLXqdMsVG7i4OhlOhUsuWiNZaWlk7MwG+na5naz",
+	"JHuVzmmiBP4FFM9CbniVP6eXyH0Yb7NLlHIlnQ1R+JpIHr4httVSeeullHZb8R3dPzesebtkz+aN9RNm",
+	"o5QX0koE+Lxdsq/n/oxoBY4t7uKhCgxPKLe2WPybCcXXtSqNKN3aEmOtZlr5mcKYavGu4ky4SyEUe4bl",
+	"vv6WPcFbGisvxFPg4oaC6sxefP0tRj+hP57lDwgYPGFI4ZaocYOiz8sxmobURicu0r4G9iEye3X7wGqi",
+	"qlPWEpb028H4WvIWXpamzQhNVBdnE528Hb4oLORNNSZdvn/hOOinnghFoP6IDDiBb6TbwAJymlm9AXlq",
+	"Qg5Qp6E5OrSRNRTpCh/xSmwbvDYJHu/+HfpkUeVGjX64v/ONaLN1zrhl+LBINvA5rxCzDA5hWnOdmJ4J",
+	"DgaFr8ueKK2KDayd8qnXZ235y3WMl67Zbl3QXV2o1XDTUw1eaKXoZWzdYixPdNK1WVyb/Dh5DV19/PDO",
+	"bwwbbUQbLXcWcFytLcYIZ6S4yK7YLugvWiZxuwic7zVQTgLyuLN5Gq7sUhgfYq11+NYXeEtpaO/DGGva",
+	"sJLv9s/fdDnQY7il91GtgG49IBHe39Q7nm9p3sQSC3CconHv8SEHoXXcuJ5QsadyE6NcLzu3apExCBi9",
+	"lD7+EjbXnK0izzDmnBULrUrrfediqxfrAYzz0scWb9PUXLPRLLk4g4mt13fDVdW5YX7EFYhWytmu06yd",
+	"436CKiICmMDAnvISIBWL1sS22J4ONxLZL8jxxWZ7EN/ry+C5dG05hr06HKPv5NEvLcei72j/MR7k+/VK",
+	"0hyZFVNakyrYIMFCyLcIX8dkKdxl+ZbCVitdr+Mfd4kDBu1rpAise8bT9GxO19+UvsxnvwqjC391UhzC",
+	"6Hh4DhaJj/RLly9hGrja9c/CwDPutkh2haoRib2JbFxrxK2eEWaXZ/PmNOPkxThc7avb/QWpxOVQROKT",
+	"JLhwctbBpjuPBroXkyFOMR44nWY4vZcqhCk+0Lmpq3IynUm0xymEUmStm5E3xa1M8zHqVx5z4w4102O+",
+	"RAHrc9nSk9y9TvHndMX3+WW1Pj8XYivV6hjD8ZHPg1rtStxKKGGl7T+JrNZgz8FnEJtE9WDT7ExU2ive",
+	"+z1EBMJ74DErgSbv29djVO81HMJEFli0nzFQDrp478v7pqH8/XNjYngr/4ScyvYvFDgl0VuCVx75721O",
+	"tc9KWLN8Cwu+wQQs1lz23tOIsgefJrDHE20cijODX+6fk4iQcXyzzVLpLNBIKxE3GSA0VsnauHbddJN/",
+	"xlfIHILg7WubyTBjW4E20arGf1G0S48wpkDKovQaNqn9V1na1quB/fF3Xgh0n3Dts+RKIVMqacnwT4ld",
+	"aEMR9nA7cLrzUGrq+4XBJ2FtGgujtesjFM/g6WtFrR0mmRHKRfSqwM2pOxKQcW5wb0om4Ij9AJtbiGAI",
+	"Me3nTAKYl3AZ2tGBeyPMeQUTKSBwvraCVYJfJPlysLWvLDu9kqXFaa3ElVwAon+7loswvW/8HSW6vaIM",
+	"QH/PcF8VDfr29Erh8EotyCeWjpOGGeDSNkQ7TUc8p8io3Z/hh40V1YWA+MKXmoiwzbNAPIW2agBySSrG",
+	"WSmXS4H6BIeD3jKs13xIaMKsD/h6Mjbrx/QbaIUrVaCN0uM1dOSavlKvqBCL0LjUWd1ZGhtyUQaBqkS5",
+	"EmZOgLxw89w8dAXnjDau8dAvBTIKNbBUzuiyXgh6fHjSkseELLlHUoyJ29DmI+L7pBsNncHiDLofPLDo",
+	"zX5Gikbp9gg7qTKahp6QckzowuOwKP0rIT9UUT7NbyL1dmV4KQrruBOT9ryPVOMEKyQtXOjDGvgHlO8a",
+	"dC0bqmWZ5K2J9Poeb/ObPSeny3pNxF6w6BvKtGFERej8BtE533ddCVFYqfLXXUshULfzxUJsXTvDBXwD",
+	"3YMOPFQV+AIx2AAwwwpeVtG7gQGjpVjwalFXhI8dsEguF7wybYxAJZYO3XRp9pnmDkhCX2d1gNWE/gwo",
+	"wKQGrCgQ010Og4iLYxRu2IvXA3wlJ3wpeGg2cKANcwFdNGTMk5fikXKyqRBFTrP90XtuE/JpMXmpGyYS",
+	"pqKHuWU6z1thpC7lgkn1i/CrOaqlIDGovhdaOalqUDTMiIZu2icYnjy7+Ml9CTB+He/TxQlp3jgQlLhs",
+	"zXaZ2J1tKLqFyCopvNkGSM/UOTXCyrLuubsyfNGm7DBhDHFzuRPHJk6tvSW57DoiwyIfWnQZJGUqNp3Z",
+	"2udSr55qKd8pyoo3eF+vqPddJeHlfyjZc0bTToeLF1+jaftCGJs/sfsoCoNtQ4lW+/ADNL5FDMbhvRR8",
+	"C+EW+m6/oexO2LbMBeOL3g5ifQ/ly3GwJ1hEJMBeSrdYFz1PXaAslQAaPnRPhPtdkgmBq1Asl2LhptCA",
+	"bybIZ9RLBX0GKl4LXuIjt8Y7GpK2tEl58nfNoGmb2DXKSrRCG7MGW3l6QIyf0M+o8P9DT5T9C43/oiuW",
+	"8WXgP3jZ6bn9ozJeeJq3k5zthEWuxLD+yRrZasur/JV+6LQUFd8NdYkF2p1GwzagGmjPQeg9bCjiSizq",
+	"fn9c6Nqvs6HOoUh3wHF57q+KNGJ9dyb/Zow2aWibjndXMQElWIgmT6cajd95xfD+PcY5aE8gfEsO4k2f",
+	"G2EtX4nkW48/MRTMiWAaXW+fbLbGzxQFIhJ/3zTuxa3OEGrlZlsR5sHrU9B+aS02FDllICTKgffsvx0y",
+	"8hHbeE1s4/5b4WFAYzcKY0YcY1amNGWiayVbCmA8rtCngRpWZq58euP7gEnR7ecadyFEEbw7zPTxvsnl",
+	"1CJdqqZrdNUQPKQnXZQYcimOErjnzFsbYeGebegaMXETKSHKvmciXlf05Z3JSknKsJSaprWc0OTiSWan",
+	"dO+M4mqbPudMnshSUk5GGVeGH4DcSnSNKddmeWLykf5yARFO/IDjuYganIccZj7qwTwEL9BmL9hAHng1",
+	"ck83SPfIRZ0fy8CN3RC6KPjUPMBoOqgIXogU4F0fB8G0XxCNI2F8zJs0eUhfB6W0TqqF6zz3RbRMqyPc",
+	"ELCIf92NY4xxDdC5F2+C8emUMJSaC/jbR+jS/DqRBx4sOmHw1UoXfVig1K/iAUFbvtvA+kmDowzDgHxW",
+	"pImEH4BgAp/bIM1bLgkXI0RfE7cIVaNFNTpMuCEntTZZQO8ZBIe4iwmLIUGvxFhXRtAfvXGubntNbPlu",
+	"lOVeZidw+mHi/0aGNzasvRQ/QZPuq75Ux7R1Q2sV+7U372D60hXQQfi1ZCqZtS7270rl4USn7Tiv3bzd",
+	"mELSYnBQn1gb7ldwo8lEDFUlhRvFFLNcMaEuRKW3IlsaSJnyoIbylLorRQCTE/zz9ErlyiZ/UOlkeLmo",
+	"l4jl8kllrhfxNnkp1LwIpE3iJi2+wRaaFoP2vkmbAYU8IcjjSpngbwxnj/AyAY8Zneva5uVPHUK/BiRY",
+	"s5/rGLypuT2mh6Wxj1KW9BQ418c1YzcOxuQwizQiBfe4dHxhRVU3HPSlKZoQaj0RQOjMWgw8PF3gy1Nf",
+	"MIQjBVaNWrkooGYjyuGDbQS6JQ3SA/9Qf0LwyaLnzXuSbjYgfrEke/L29VMmEyBwElIgyW89OsilEH2X",
+	"gx08BZgdQ1ZHfzSj5UUTuQlLdR26o1ROBLJ974Mj+uL+IvuBotdaRPp0mPtNGV3nwUorg/7P7yhPechc",
+	"DuNjeNomaIpd8798/c3xN3/5d1bKlbDuCB5/KbY1wj/c6wR2a88Gk03AuFYITIaERc8IPWkLWc+bPkO0",
+	"yjzOiTtq5v5nKDczqai/fZ2tpZzhpAwKvVxmw438iL8Hr1u4ugivMTvcnaAlgg1yrf3nP7FyNLn7V2h1",
+	"EUONXW+BVqIvAFF1lRHT598UjaQesXdQmwkAvy6EZZva1bxi4grfQZI3uH0ahceBrglPjO8CFWC58Zig",
+	"mA7hmzqyHJmN2Aa+QCvSeoAO0BBfQ0Rk/xMKMjEnIp/CIcxkRJrVyskKfwU2/iPh4pajF42x/1rLKiMF",
+	"Ww3fbUrHnClNtnerJCHRmkeuRLN/Y9USpPtdTkr33PArH0sO7Cp8u4i3Jb9FfFo8MV1zGb2n2gQewFiq",
+	"Zti8MT3mTag9Fpm2L204tA0f49t5nzGcfPl+6e5FQIm2IFifaPTFa9IQ0LsxGEiTg15f1ugGTpyx4cky",
+	"HQ1CJeFdAH5LSYNeXtuvTTp24NwajS7aPXP7lpykX/tCy4A5TihpWv9fDQwnNjMsFbZHKoI1PiQTcRYO",
+	"ENuTWKedGnyPMvjQvkttBd5tgwfxwHHEXkdQJxTzcMAG6UmnVjxnkirEUvQWPmyGQhpfDoGy1mkjSgza",
+	"C+AOulrOLFxfgDZGKLO/Rfoi4BmImQAy581QDBwBTbncmS+UXJpfm4KZ4+b8RvnU88vCz1yBLWewP7O2",
+	"AY7GTWvDTwIJNWLUSMSIeyI9afdBHNBlRM8Rq0ovfOoRI4qW65pmH1/P101cj0/qJYOd22ue2FSBvs54",
+	"uY+tB1D+UaaSa0Kadat1uzwwlAgN/vRK0WgPeGj56dNPV1yW/q1KK0yE9yR6/oiSaeNx33Lpx9kXRPB6",
+	"sc1G5/hNTyiHdI5Vrzv+OoylHgcY23d7CmdKXpadt/6tx2YIw48ROYjb/j0pCgu/7AkfMTiby8HZHGi/",
+	"4472qmMgsHhQNYQ9uwwcpxo5QEH/xXjzcnK/6ymLPzqwJolG7sLjOsIReh0Qj4FoY3yDIRlexowBnjgd",
+	"6TtiXoVQM/F3Ezblahm0WTgdB/9LJ7I7kmvZhm9vNZbZqPJIKO73jvU/Yu7cVNnYXvLUCxtonHDd+PHD",
+	"x8KxoYfW8zOIX7voMp7GlmgS3YTcWxHFnJsc2n6aOFVNhCTyN6J7EBuOIWmaHlJew8MIMAerS76zwehu",
+	"BKu/ucBVH5Fw3+BLsdN0UsjzxizwvP5BLORWiibxR2telj1puwYsXu5N3tN1AHUCxJ8qBAAPb0Jbtc/k",
+	"4Ujug/TwZIOeezbzqn2cpYbDsQLKvApthxHFKU32swkpBDIhzyJLR3Sed5oMKrsc4mCCjqNapOSom37t",
+	"prrxynsO2AoKYYRrbs7b2WBsO143oddU0ZeBJAGfXSP/gD+Wvm9CxNc2PST+Qxjyq3zgqtQb9qZWJAVP",
+	"/vHhzVOf5S8IWXhNJFik5AGnJljupybIBOgHltxWUoLz8jdKSlDtJSW4/kinpyMIstWXjAAlTapxLMI9",
+	"ZyEYUjPBqTSsZzJX/VMUja9Gmsb3dD1DauPaj7JaWfxcfBje2SJvZI60UhnBW0VhfJjBllnSviVs4hWq",
+	"eNmXXnWO3SK228vfJAaLBDtBLG0mL4710TF8j2k6PsK4UpzFKjETlggbabOQRdTugNdp0ErwRkIoM+jA",
+	"6ts+p+6ZJ6l7qk0Jun9oNSbY0iZ5EG4QGJCHotzhG3ePF+y8Y25Y6TOXZhD3mGPNytXY8ThH/LtQN8nv",
+	"fp12AsbYO+7yO6ZE19SJ46rkpmSi/OYvf/n622a4D0xd7TMpe9njh/WestVxJxdtiy+OboISC1N5tNL7",
+	"Ksuses7NBglAKhk3q9qj+85aF1CHRUlAQvLjTQYb3OIQZSwRdW0Ibd38hMAyuBltVGc7KydXnHl91b04",
+	"O/3by3chF+D9W0RhURQ3ckd3lkef4mgWyUNYG6l6JHmYqhJ/SDTJ3gg3fojkoAR5CcGxkNfbSoBt1+jA",
+	"/XWzMLut08dhamjLD32eyP0g7Wl7ea7XZ54qoAVhp5T1NLG48CjdUHWNUB97/DlJ6Trs6QDcYazBhT/2",
+	"TmDfusxX+nLg3J50eNrmePPoIEvD9pyIuN+1PCID90/Sl2xCb6mWOqSb5wu0G0PMfu9amvlwpbO1c1v7",
+	"4vj48vLyKPidjhZ6c7xCfFXhdL1YH4eG9pKFh/Z8qA7QwtXOyYVlL9+/RZtJukpQfkqA8iZvRl7Mvjl6",
+	"Rs+hhOJbOXsxe3707Ohr4tgaheCYnuPNXnz+Mp8dX3xznEKSV9nMNYKbxZoOAr4skAGShfbU2zIWeqPN",
+	"yxSNGiJ+z1781JciAZYs/P3PWpjdLMTtTR0mTQr/qbkT8j/vIdoponVyqAgp/p320Y2Oeqir5Ea6IdK+",
+	"7HfXvN7mK5H0dsQ+elgzfqX898GwDOiPEOEjVuohDJrI0dUI936uJxqzN2rx/pSr4I1eIZIPLxJUcjF/",
+	"1Ao/4N2XPkC3f4W42LFaVfRsLblJs3FoCNamZ3gL7jkQ4jN6VIDPqZwbaOik8BQWQOGBMzI89MrHun+g",
+	"44YurjPotwTViEs5vfvzAe5xvDYJr9hHTAMj7he5UYzF8OdR8vHA2wkCFNDHTk8fC9QufsMBhVN5GBce",
+	"zzt+++iFaJ6pzpOUzvZcbrekM5Jg+bnBerxOQe3kBpvc502bgv305MGJqvejH/QKFL2bwKcbN5XrBkuU",
+	"ew3ZR0F8htf0HaAUGh8Lws5HzwdnCDhrebEzaZv7KaX2EoLxrjjrwqwaRGR8yzPGy1ZDhbjaSiNsCHpx",
+	"HUVJj3QRGBMOiQV74iXtKeN24VfZ2S4UgLIhqi00yQpWcbMS1oWf6cnPnDnMjLfDNMa+meAmYj7xk2Wl",
+	"xisdL7255Ik5RiDZ2RltLqdTIg+axGAyBOhHkzaBJgeD6W2FQU0A4zWCWwx8F0STTCS24RDFlpXSQtJ2",
+	"DHqC3qgWdKV3jPEVaf+s/jyfheALaOt98+xZMGi9/zfJ13D8i6WTStNg991oYzVOOnKFh+6ZE1WIczL4",
+	"vCGGqHPrhuuXYiSBpA/8gkZVJn52eK6y5SupPDgIvaobfo4iqAj8CqduEFq/h9PcYaPNxZK37fx8TnBu",
+	"NoZymwE/Zw8gbcqfIEbnKTbq+AoM7JlFE3z285eOYX/82f+rkOWXXiv/ndbngKSPCcKbCMDsVXdvMj5b",
+	"D3Naz9my4qsVfKfk8H4q2ucDat4LwXc7tPsHzweBkLjrotjDMSbdLsK4ZilvnanF8P6dHA+oicy5Iflw",
+	"3cX/wBbrAUv0Dpdkfhnc2iqoUNBGVsExGnpraZ02u7ElESNwd5Jiz5muSmEdbWEjIg9x27/3/f1+JP+W",
+	"xbDD9Gn7RhPw/n63jq6cpsTfr7AGn6qdIqndaC4tvFKEIyUuVIkhq5IYLXrpb79iv8khy18utA192A9i",
+	"cJ6BRfBDHMgfdQncqanTkpNJi6sbhWhvhfVEpAs7WNPj3a6A9lnmAL3dRWLcRIW3YB9/dF1+p4LcO92T",
+	"hDoXJelQwc5TcLdCnjzaGRLrtb5MJPgr25tMf0CUPzTp8R9t8Puxwe90wUzMzNGZ/bFFEFq9W7Hv5n6Y",
+	"cjrt4ukGRD3NxDAm7483Qvh03JTtwZ/taC2hT3qrLWVOSTNUkFMQ/Xvphjpv3Y/4a5GlrJww+35C7x9U",
+	"4rKnPjpm8rU/KeAl5XuQloIbSdVh4ZxZzaSjMPJKiNJ62AslC/HZKZaEniWHzzVcinYxo7UxyY2YxNWA",
+	"GV3KK68AAhh9QTF4mgQOCiPphjCv2RlHUCs2dvA1BsGTZl9Gvn7Odhxe8uX4cp13iDl+ydXpbiu8GFj2",
+	"S21dlNO6QVvGTSA8Ho0wAxRVOB8VEfQGv2zoJzSIT+QKfqroJ4RwEYAlN2iAIfWO2mK1Df0P2ps0yMR+",
+	"9QNpo9fOdj5MR34S8pfm2Z339Gp/0/W/3f9+O9old+ldUtr1RqpisPtY4FZISOKpJTTwqxEa+NW1aPjt",
+	"kRJdLiTjJ93p5Abuxr3O4op9ePOKPX/+/FufKNqJ0huRfcyhJkO8smYgUfeU3MXPUzTZhzevkICTiDSa",
+	"VGpUAKL03dbIscWHN/BHXMgfCxfyezrrEZfCNXD0zxdOD1tGodQw4OF3dO4T3FRS9Ecx/ZGM8b2svq2w",
+	"jUYwfsFlBffOR63MdftggyR9UHjs6s8jGPnRoDsC2EQBhSq56KZi3Q8bCc5jsXUP/fp2P0vhzbMKjhzA",
+	"Wx3e2ikcXyJPQntiySGsJzX1eKqecKp+gycWOrAQYxvZI1MivsdunkdlNT0Vu+3eN1zxlRjt3Re77d4z",
+	"ecvzChyL3XbvPqjFSOdU6tZnvfPSvHfafbnb7h+a75Vyvrn18dZK9rpV4Ntt9+dgsUuFSd7pKZ80OOg5",
+	"ksK0YR8/vOujKPx5AEmQgS9pn6uSYSu4lXMj5kyulKbn5dx6f0R9Rg22EXVqR8EhvOsHTU0o7j1FRUg1",
+	"14qHHUuJK75wrMAfL9e68p96F7ZbrPNOjEAcZZoktxI2PsmbsQ8eDMZvP3oQSjCJjhkjKnGByLmCbbR1",
+	"zMqNrLiBzSPLZu85JEtHifi4DcUhedfZ2R6YtDGl8RyFSNeOcdYYINdxBiZRH/wwJvHs4Rz5/aJqoMcY",
+	"xErvRBlYfD3kcWzmPm3xxvSZBj2B4o+AxYiACdbeHcEVsfnjz0G4x6GKpCUSoGLfTRAUzGMOc4fcdNGO",
+	"HHHvDLBnfVDfSeJ5jyAo7PLO0E9dCTj2sOxJwKeQGj7F0+PbiSSTFxuUkNDZ43nm8+0B4G/xPuDRJfnH",
+	"ckne6NkJsab76iS8ah99dXKDtyRJzwc9JXnIL8Ae6D6Z7g/TAI/dNKCP1p3f2s+a3e++dncfCfkAOGc7",
+	"Bd0CsX0R0unTirbwJ00yNelsjNu7FwB4yCygsLu9eM8HujL2eTv9yEMjfgig+84o7k80bb3dVrspUEwY",
+	"Xq2ka4khKuM13ESxE2wJ2MEVo/san6GNSUtYpHj7knjdaSGD2Gv29bNnz57FTWBQWKm3MQv2Xmy3h7ow",
+	"7lK7N2Izusj8TI1dAfkW707SD4FfpmVb0ZYHZfIRgfmIwHxEYD4iMB8RmI8IzN8nAvMR//iIf3x0Nv5r",
+	"OxvxXjhCClNDtwkkFPyPUqWRgV2am5TyTfWJevQR3lNwqVd6cyaVaLxCYQRN3BmnYaKwUJpsKxTEFCQB",
+	"EXbETsOkFH9iRleime3muTlmBtk1Z2E6xLfzwXFVNphNn69TiUtMDaeN/DXxxCI1VHiErwVQlLcCQuKp",
+	"GEh6Pgtps7hZiSZFQ4Nvor8bxFGabCv+maBywnAmGRitiQkDBaakQ2lmyV5jmtCzywIkliZKgchU1Y45",
+	"n6GXW+QtMWXO5JLtdM0ucd1X8hzriyu/KNZig5PbiVyEOUfqXhCRuEo8zaPg29t0HDziiB9xxI844rvC",
+	"EaPbwR5/xk4KctiMYjZiJuyct+g7+DjmISJBp+7yrxRSgm6oMADLt6kXMXc0kv+VbQue00GF4Ml2WVdV",
+	"64ZSXAizY50sjrKEa0x0hHRtiLevLdpCyW+FLNFDo7QSaTWkh60FL4Xp0VWu7X3b3xuBXqhbwmfoILeB",
+	"3VSXDUk2Tfs1pRATd9vjz/j/KaihXApwrhh3etM55GOT8+D1IdeWW4sd49ut4KblfusT6f8DbYyJNBZi",
+	"b1/PY3h9bkOiAN+JDfkZCK5aWL4UjFfbNT8T7ii/DgJLDnir87hpPW5a/9KblnX8XPSqiFPteMWwDGh8",
+	"CtA6Zz7eK9rnSrtONrMGrxHz3lXcNZKXZFgORY8Y9mSHIpnmtMkJUv/7WaFKu3aWt2KjVS77QOI9gFtT",
+	"PD62ogv7yK9NYyLfo5+qA/qJk5uEts+0qw5tVo22OiL/fczrDrJD3C2ulIFXYsnCZo5kOdxRzdla1wY2",
+	"qpLv5q3bpCP2va4NHXRLviP1JxX7ePrqiKGoWardKULJ9xp1i/1Y2oztlhOeg75J419DkGPSrzP6KeZw",
+	"9PUbpKgwTQ76ZfRw19uV4bguYe0yn2UhLHJJ6RdIPs+F2CJBm4G1O/5U7p1QKxetTWIsGpWkSgp0O+G/",
+	"4Ufkc8G4wn/NPSfhS8l3bZAczQUV6EWEOWEueJU1EoNQQkez+azku2tFSX68P/hjOdH/wBcnd4tk+D1h",
+	"YsJGMsliJDV5qK1Ifdzq1nfAe5SXnYTjB+yHtOnF52ahDdvOs82NYJVYOqbrYajN4ybzuMk8bjKPm8yt",
+	"weUe8vu4Ipcfulbyn7WIL4iFcrDsTD7f7sPZ9hLtPbb3Jfy/221wEjI0CRcyHJQzBg15hIM+wkEf4aCP",
+	"cNBHOOgjHPQxIOdjQM5HQOojIPURkPoISH0EpD4CUh8mIPW3BJGOdi+92ssgl26IJurQm8CJ7ugIMzxQ",
+	"n3kMFjKr4AgAv6RZfo1eGb5ha27X1x47CmraUtAFlm8Ee/4NO9s5gVCPVHnRy2+gXl+GW+Voj/vWev0U",
+	"cF7xZQro8SHwNyaR84nfrGdxkzWO27DjSGfT9HHW87CVYI5MM26xMBwKK9EcAEdDjiKHmh7ubLd6xL49",
+	"Yt9+79i3+ewvJLXt78IYbQbD76VdHn92V0MXniEAX1jKaFCZjSgzXhH2sgNujmEvVjU3XLkmQqf33cPM",
+	"SIWqAlOeS7djl1KV+pIchqBy2CW3SbdSsQ3JK1fN9SXj7M/PvmU49jS8ZzP3IWacoWtYqVjJHT+iP8ko",
+	"jHihxMBYcBXMfyB9sdbawuJwl7A+hlBAqVBMCSXjnUfT8bv5YwS49uMG+EdMkJaI4EHrdvo6PXSZ/vkW",
+	"EfR/Awn/4Kci1+93vGQfSBdS33++v74TfuKG8AZ5h1R8+9tQMaY3em7FvsxntNfRaq1NNXsxWzu3tS+O",
+	"j8UV32wrcbTQm2O0JHz9z9HOo3a+zOMvXgN/+fnL/wwAdrCE6fc4AQA=",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -27,11 +27,26 @@ type Account struct {
 	// \[spend\] the address against which signing should be checked. If empty, the address of the current account is used. This field can be updated in any transaction by setting the RekeyTo field.
 	AuthAddr *string `json:"auth-addr,omitempty"`
 
+	// Round during which this account was last closed.
+	ClosedAtRound *uint64 `json:"closed-at-round,omitempty"`
+
 	// \[apar\] parameters of assets created by this account.
 	//
 	// Note: the raw account uses `map[int] -> Asset` for this type.
 	CreatedAssets *[]Asset `json:"created-assets,omitempty"`
 
+	// Round during which this account was first opened, or opened again after being closed. Not set if unknown.
+	CreatedAtRound *uint64 `json:"created-at-round,omitempty"`
+
+	// Whether or not this account is currently closed.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// Round during which this account last sent a transaction.
+	LastActiveRound *uint64 `json:"last-active-round,omitempty"`
+
+	// The logic sig program of an escrow account (sig-type lsig), the program hash of which is the account address.
+	LsigProgram *[]byte `json:"lsig-program,omitempty"`
+
 	// AccountParticipation describes the parameters used by this account in consensus protocol.
 	Participation *AccountParticipation `json:"participation,omitempty"`
 
@@ -79,9 +94,49 @@ type AccountParticipation struct {
 	VoteParticipationKey []byte `json:"vote-participation-key"`
 }
 
+// AccountRewards defines model for AccountRewards.
+type AccountRewards struct {
+
+	// MicroAlgo balance without pending rewards.
+	AmountWithoutPendingRewards uint64 `json:"amount-without-pending-rewards"`
+
+	// \[ern\] MicroAlgos of rewards credited to the balance since the account was created.
+	CreditedRewards uint64 `json:"credited-rewards"`
+
+	// MicroAlgos of rewards earned but not yet credited to the balance.
+	PendingRewards uint64 `json:"pending-rewards"`
+
+	// \[ebase\] rewards level when rewards were last credited to the balance.
+	RewardBase uint64 `json:"reward-base"`
+
+	// MicroAlgos per reward unit in the consensus protocol of the round.
+	RewardUnit uint64 `json:"reward-unit"`
+
+	// Whole reward units in the balance without pending rewards.
+	RewardUnits uint64 `json:"reward-units"`
+
+	// Total rewards, credited and pending.
+	Rewards uint64 `json:"rewards"`
+
+	// Rewards level of the round.
+	RewardsLevel uint64 `json:"rewards-level"`
+
+	// The round for which these rewards are computed.
+	Round uint64 `json:"round"`
+}
+
 // Asset defines model for Asset.
 type Asset struct {
 
+	// Round during which this asset was created. Not set if unknown.
+	CreatedAtRound *uint64 `json:"created-at-round,omitempty"`
+
+	// Whether or not this asset is currently destroyed. The params are the last ones it had.
+	Deleted *bool `json:"deleted,omitempty"`
+
+	// Round during which this asset was destroyed.
+	DestroyedAtRound *uint64 `json:"destroyed-at-round,omitempty"`
+
 	// unique asset identifier
 	Index uint64 `json:"index"`
 
@@ -94,6 +149,30 @@ type Asset struct {
 	Params AssetParams `json:"params"`
 }
 
+// AssetConfigChange defines model for AssetConfigChange.
+type AssetConfigChange struct {
+
+	// Whether or not the transaction destroyed the asset. Params are not set when it did.
+	Destroyed bool `json:"destroyed"`
+
+	// AssetParams specifies the parameters for an asset.
+	//
+	// \[apar\] when part of an AssetConfig transaction.
+	//
+	// Definition:
+	// data/transactions/asset.go : AssetParams
+	Params *AssetParams `json:"params,omitempty"`
+
+	// Round of the acfg transaction.
+	Round uint64 `json:"round"`
+
+	// Sender of the acfg transaction, the creator or the manager at the time.
+	Sender string `json:"sender"`
+
+	// Id of the acfg transaction.
+	Txid string `json:"txid"`
+}
+
 // AssetHolding defines model for AssetHolding.
 type AssetHolding struct {
 
@@ -106,8 +185,17 @@ type AssetHolding struct {
 	// Address that created this asset. This is the address where the parameters for this asset can be found, and also the address where unwanted asset units can be sent in the worst case.
 	Creator string `json:"creator"`
 
+	// Whether or not the account has currently closed out this asset.
+	Deleted *bool `json:"deleted,omitempty"`
+
 	// \[f\] whether or not the holding is frozen.
 	IsFrozen bool `json:"is-frozen"`
+
+	// Round during which the account opted into this asset, or opted in again after closing out. Not set if unknown.
+	OptedInAtRound *uint64 `json:"opted-in-at-round,omitempty"`
+
+	// Round during which the account last closed out this asset.
+	OptedOutAtRound *uint64 `json:"opted-out-at-round,omitempty"`
 }
 
 // AssetParams defines model for AssetParams.
@@ -150,6 +238,69 @@ type AssetParams struct {
 	Url *string `json:"url,omitempty"`
 }
 
+// AssetStats defines model for AssetStats.
+type AssetStats struct {
+
+	// First round of the totals.
+	FirstRound uint64 `json:"first-round"`
+
+	// Last round of the totals, the same as first-round for a round.
+	LastRound uint64 `json:"last-round"`
+
+	// Time of the first round for a round, otherwise the start of the hour or day, in seconds since epoch.
+	StartTime uint64 `json:"start-time"`
+
+	// Number of asset transfer transactions.
+	Transfers uint64 `json:"transfers"`
+
+	// Units sent by asset transfers, not counting close outs.
+	Volume uint64 `json:"volume"`
+}
+
+// AssetSupply defines model for AssetSupply.
+type AssetSupply struct {
+
+	// unique asset identifier
+	AssetId uint64 `json:"asset-id"`
+
+	// Units held by the creator.
+	CreatorAmount uint64 `json:"creator-amount"`
+
+	// Units held in frozen holdings.
+	FrozenAmount uint64 `json:"frozen-amount"`
+
+	// Number of accounts holding some units.
+	Holders uint64 `json:"holders"`
+
+	// Units held by the reserve address.
+	ReserveAmount uint64 `json:"reserve-amount"`
+
+	// The round for which this information is relevant.
+	Round uint64 `json:"round"`
+
+	// The total number of units of this asset.
+	Total uint64 `json:"total"`
+
+	// Number of accounts opted in to the asset without holding any units.
+	ZeroBalanceHolders uint64 `json:"zero-balance-holders"`
+}
+
+// AuthChange defines model for AuthChange.
+type AuthChange struct {
+
+	// Spending key after the rekey. Not set when the account was rekeyed back to its own key.
+	NewAuthAddr *string `json:"new-auth-addr,omitempty"`
+
+	// Spending key before the rekey. Not set when the account was using its own key.
+	OldAuthAddr *string `json:"old-auth-addr,omitempty"`
+
+	// Round of the rekey transaction.
+	Round uint64 `json:"round"`
+
+	// Id of the rekey transaction.
+	Txid string `json:"txid"`
+}
+
 // Block defines model for Block.
 type Block struct {
 
@@ -174,6 +325,9 @@ type Block struct {
 	// \[ts\] Block creation timestamp in seconds since eposh
 	Timestamp uint64 `json:"timestamp"`
 
+	// IDs of the transactions in the block, in block order. Only returned when transactions=ids.
+	TransactionIds *[]string `json:"transaction-ids,omitempty"`
+
 	// \[txns\] list of transactions corresponding to a given round.
 	Transactions *[]Transaction `json:"transactions,omitempty"`
 
@@ -260,9 +414,91 @@ type HealthCheck struct {
 
 // MiniAssetHolding defines model for MiniAssetHolding.
 type MiniAssetHolding struct {
-	Address  string `json:"address"`
-	Amount   uint64 `json:"amount"`
-	IsFrozen bool   `json:"is-frozen"`
+	Address string `json:"address"`
+	Amount  uint64 `json:"amount"`
+
+	// Whether or not the account has currently closed out this asset.
+	Deleted  *bool `json:"deleted,omitempty"`
+	IsFrozen bool  `json:"is-frozen"`
+
+	// Round during which the account opted into this asset, or opted in again after closing out. Not set if unknown.
+	OptedInAtRound *uint64 `json:"opted-in-at-round,omitempty"`
+
+	// Round during which the account last closed out this asset.
+	OptedOutAtRound *uint64 `json:"opted-out-at-round,omitempty"`
+}
+
+// MultisigAccount defines model for MultisigAccount.
+type MultisigAccount struct {
+
+	// The multisig address.
+	Address string `json:"address"`
+
+	// Participant public keys in multisig order, as single signature addresses.
+	PublicKeys []string `json:"public-keys"`
+
+	// Number of signatures needed.
+	Threshold uint64 `json:"threshold"`
+	Version   uint64 `json:"version"`
+}
+
+// ParticipationChange defines model for ParticipationChange.
+type ParticipationChange struct {
+
+	// AccountParticipation describes the parameters used by this account in consensus protocol.
+	Participation *AccountParticipation `json:"participation,omitempty"`
+
+	// Round of the keyreg transaction.
+	Round uint64 `json:"round"`
+
+	// Status after the keyreg, one of Offline, Online or NotParticipating.
+	Status string `json:"status"`
+
+	// Id of the keyreg transaction.
+	Txid string `json:"txid"`
+}
+
+// Stats defines model for Stats.
+type Stats struct {
+
+	// Number of asset configuration transactions.
+	AcfgTxns uint64 `json:"acfg-txns"`
+
+	// Number of distinct accounts that sent transactions. Not counted for rounds accounted before the indexer kept stats.
+	ActiveAccounts uint64 `json:"active-accounts"`
+
+	// Number of asset freeze transactions.
+	AfrzTxns uint64 `json:"afrz-txns"`
+
+	// MicroAlgos sent by payments, including close outs.
+	AlgoVolume uint64 `json:"algo-volume"`
+
+	// Number of asset transfer transactions.
+	AxferTxns uint64 `json:"axfer-txns"`
+
+	// MicroAlgos paid in fees.
+	Fees uint64 `json:"fees"`
+
+	// First round of the totals.
+	FirstRound uint64 `json:"first-round"`
+
+	// Number of key registration transactions.
+	KeyregTxns uint64 `json:"keyreg-txns"`
+
+	// Last round of the totals, the same as first-round for a round.
+	LastRound uint64 `json:"last-round"`
+
+	// Number of accounts opened, or reopened after being closed. Not counted for rounds accounted before the indexer kept stats.
+	NewAccounts uint64 `json:"new-accounts"`
+
+	// Number of payment transactions.
+	PayTxns uint64 `json:"pay-txns"`
+
+	// Time of the first round for a round, otherwise the start of the hour or day, in seconds since epoch.
+	StartTime uint64 `json:"start-time"`
+
+	// Number of transactions.
+	Txns uint64 `json:"txns"`
 }
 
 // Transaction defines model for Transaction.
@@ -552,24 +788,48 @@ type CurrencyGreaterThan uint64
 // CurrencyLessThan defines model for currency-less-than.
 type CurrencyLessThan uint64
 
+// EverAuthAddr defines model for ever-auth-addr.
+type EverAuthAddr string
+
 // ExcludeCloseTo defines model for exclude-close-to.
 type ExcludeCloseTo bool
 
+// GroupId defines model for group-id.
+type GroupId string
+
+// IncludeClosed defines model for include-closed.
+type IncludeClosed bool
+
+// IncludeDestroyed defines model for include-destroyed.
+type IncludeDestroyed bool
+
+// Interval defines model for interval.
+type Interval string
+
 // Limit defines model for limit.
 type Limit uint64
 
+// LsigProgramHash defines model for lsig-program-hash.
+type LsigProgramHash string
+
 // MaxRound defines model for max-round.
 type MaxRound uint64
 
 // MinRound defines model for min-round.
 type MinRound uint64
 
+// MsigParticipant defines model for msig-participant.
+type MsigParticipant string
+
 // Next defines model for next.
 type Next string
 
 // NotePrefix defines model for note-prefix.
 type NotePrefix string
 
+// Order defines model for order.
+type Order string
+
 // RekeyTo defines model for rekey-to.
 type RekeyTo bool
 
@@ -601,6 +861,16 @@ type AccountResponse struct {
 	CurrentRound uint64 `json:"current-round"`
 }
 
+// AccountRewardsResponse defines model for AccountRewardsResponse.
+type AccountRewardsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// The rewards of an account at a round. Pending rewards are reward-units times the increase from reward-base to rewards-level, and are not earned by accounts that are not participating.
+	Rewards AccountRewards `json:"rewards"`
+}
+
 // AccountsResponse defines model for AccountsResponse.
 type AccountsResponse struct {
 	Accounts []Account `json:"accounts"`
@@ -623,6 +893,14 @@ type AssetBalancesResponse struct {
 	NextToken *string `json:"next-token,omitempty"`
 }
 
+// AssetConfigHistoryResponse defines model for AssetConfigHistoryResponse.
+type AssetConfigHistoryResponse struct {
+	ConfigHistory []AssetConfigChange `json:"config-history"`
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+}
+
 // AssetResponse defines model for AssetResponse.
 type AssetResponse struct {
 
@@ -633,6 +911,27 @@ type AssetResponse struct {
 	CurrentRound uint64 `json:"current-round"`
 }
 
+// AssetStatsResponse defines model for AssetStatsResponse.
+type AssetStatsResponse struct {
+
+	// unique asset identifier
+	AssetId uint64 `json:"asset-id"`
+
+	// Round at which the results were computed.
+	CurrentRound uint64       `json:"current-round"`
+	Stats        []AssetStats `json:"stats"`
+}
+
+// AssetSupplyResponse defines model for AssetSupplyResponse.
+type AssetSupplyResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// How the units of an asset are held.
+	Supply AssetSupply `json:"supply"`
+}
+
 // AssetsResponse defines model for AssetsResponse.
 type AssetsResponse struct {
 	Assets []Asset `json:"assets"`
@@ -644,18 +943,83 @@ type AssetsResponse struct {
 	NextToken *string `json:"next-token,omitempty"`
 }
 
+// AuthHistoryResponse defines model for AuthHistoryResponse.
+type AuthHistoryResponse struct {
+	AuthHistory []AuthChange `json:"auth-history"`
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+}
+
 // BlockResponse defines model for BlockResponse.
 type BlockResponse Block
 
 // HealthCheckResponse defines model for HealthCheckResponse.
 type HealthCheckResponse HealthCheck
 
+// MultisigAccountsResponse defines model for MultisigAccountsResponse.
+type MultisigAccountsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64            `json:"current-round"`
+	Multisigs    []MultisigAccount `json:"multisigs"`
+}
+
+// ParticipationHistoryResponse defines model for ParticipationHistoryResponse.
+type ParticipationHistoryResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound         uint64                `json:"current-round"`
+	ParticipationHistory []ParticipationChange `json:"participation-history"`
+}
+
+// StakeResponse defines model for StakeResponse.
+type StakeResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// MicroAlgos held by accounts that do not participate.
+	NotParticipatingMoney uint64 `json:"not-participating-money"`
+
+	// MicroAlgos held by offline accounts.
+	OfflineMoney uint64 `json:"offline-money"`
+
+	// MicroAlgos held by online accounts.
+	OnlineMoney uint64 `json:"online-money"`
+}
+
+// StatsResponse defines model for StatsResponse.
+type StatsResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64  `json:"current-round"`
+	Stats        []Stats `json:"stats"`
+}
+
+// TransactionResponse defines model for TransactionResponse.
+type TransactionResponse struct {
+
+	// Round at which the results were computed.
+	CurrentRound uint64 `json:"current-round"`
+
+	// Contains all fields common to all transactions and serves as an envelope to all transactions type.
+	//
+	// Definition:
+	// data/transactions/signedtxn.go : SignedTxn
+	// data/transactions/transaction.go : Transaction
+	Transaction Transaction `json:"transaction"`
+}
+
 // TransactionsResponse defines model for TransactionsResponse.
 type TransactionsResponse struct {
 
 	// Round at which the results were computed.
 	CurrentRound uint64 `json:"current-round"`
 
+	// Oldest round for which transactions are available. Transactions before this round have been removed by the server's retention policy. Not set when all transactions are kept.
+	EarliestRound *uint64 `json:"earliest-round,omitempty"`
+
 	// Used for pagination, when making another request provide this token with the next parameter.
 	NextToken    *string       `json:"next-token,omitempty"`
 	Transactions []Transaction `json:"transactions"`
@@ -682,6 +1046,26 @@ type SearchForAccountsParams struct {
 	// Include accounts configured to use this spending key.
 	AuthAddr *string `json:"auth-addr,omitempty"`
 
+	// Include accounts that have ever been rekeyed to this spending key.
+	EverAuthAddr *string `json:"ever-auth-addr,omitempty"`
+
+	// Include closed accounts and asset holdings that were closed out, which are skipped by default.
+	IncludeClosed *bool `json:"include-closed,omitempty"`
+
+	// Include accounts that sent a transaction during or after this round.
+	ActiveSince *uint64 `json:"active-since,omitempty"`
+
+	// Include accounts with this participation status.
+	Status *string `json:"status,omitempty"`
+
+	// Include online accounts whose participation key is last valid before this round.
+	ParticipationExpiresBefore *uint64 `json:"participation-expires-before,omitempty"`
+
+	// Result order:
+	// * address - (default) ascending by address
+	// * balance-desc - largest balance first, ties by descending address. Balances do not include pending rewards.
+	Order *string `json:"order,omitempty"`
+
 	// Include results for the specified round. For performance reasons, this parameter may be disabled on some configurations.
 	Round *uint64 `json:"round,omitempty"`
 }
@@ -693,6 +1077,13 @@ type LookupAccountByIDParams struct {
 	Round *uint64 `json:"round,omitempty"`
 }
 
+// LookupAccountRewardsParams defines parameters for LookupAccountRewards.
+type LookupAccountRewardsParams struct {
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
+}
+
 // LookupAccountTransactionsParams defines parameters for LookupAccountTransactions.
 type LookupAccountTransactionsParams struct {
 
@@ -702,6 +1093,13 @@ type LookupAccountTransactionsParams struct {
 	// The next page of results. Use the next token provided by the previous results.
 	Next *string `json:"next,omitempty"`
 
+	// Order of results by round and position in the block:
+	// * asc - oldest first, the default unless filtering by address
+	// * desc - newest first, the default when filtering by address
+	//
+	// The order is kept in the next token, so it only needs to be given on the first request.
+	Order *string `json:"order,omitempty"`
+
 	// Specifies a prefix which must be contained in the note field.
 	NotePrefix *string `json:"note-prefix,omitempty"`
 	TxType     *string `json:"tx-type,omitempty"`
@@ -755,14 +1153,43 @@ type SearchForAssetsParams struct {
 	// Filter just assets with the given creator address.
 	Creator *string `json:"creator,omitempty"`
 
+	// Filter just assets with the given manager address.
+	Manager *string `json:"manager,omitempty"`
+
+	// Filter just assets with the given reserve address.
+	Reserve *string `json:"reserve,omitempty"`
+
+	// Filter just assets with the given freeze address.
+	Freeze *string `json:"freeze,omitempty"`
+
+	// Filter just assets with the given clawback address.
+	Clawback *string `json:"clawback,omitempty"`
+
 	// Filter just assets with the given name.
 	Name *string `json:"name,omitempty"`
 
 	// Filter just assets with the given unit.
 	Unit *string `json:"unit,omitempty"`
 
+	// Filter just assets with the given text in any of their name, unit or URL.
+	Query *string `json:"query,omitempty"`
+
+	// How name, unit and query compare, ignoring case:
+	// * substring - (default) anywhere in the value
+	// * prefix - at the start of the value
+	// * exact - the whole value
+	Match *string `json:"match,omitempty"`
+
+	// Result order:
+	// * asset-id - (default) ascending by asset id
+	// * relevance - most similar to name, unit and query first, which need to be given. Only one page of results is returned, without a next-token.
+	Order *string `json:"order,omitempty"`
+
 	// Asset ID
 	AssetId *uint64 `json:"asset-id,omitempty"`
+
+	// Include assets that were destroyed, which are skipped by default.
+	IncludeDestroyed *bool `json:"include-destroyed,omitempty"`
 }
 
 // LookupAssetBalancesParams defines parameters for LookupAssetBalances.
@@ -782,6 +1209,21 @@ type LookupAssetBalancesParams struct {
 
 	// Results should have an amount less than this value. MicroAlgos are the default currency unless an asset-id is provided, in which case the asset will be used.
 	CurrencyLessThan *uint64 `json:"currency-less-than,omitempty"`
+
+	// Result order:
+	// * address - (default) ascending by address
+	// * amount-desc - largest holding first, ties by descending address
+	Order *string `json:"order,omitempty"`
+
+	// Include closed accounts and asset holdings that were closed out, which are skipped by default.
+	IncludeClosed *bool `json:"include-closed,omitempty"`
+}
+
+// LookupAssetSupplyParams defines parameters for LookupAssetSupply.
+type LookupAssetSupplyParams struct {
+
+	// Include results for the specified round.
+	Round *uint64 `json:"round,omitempty"`
 }
 
 // LookupAssetTransactionsParams defines parameters for LookupAssetTransactions.
@@ -793,6 +1235,13 @@ type LookupAssetTransactionsParams struct {
 	// The next page of results. Use the next token provided by the previous results.
 	Next *string `json:"next,omitempty"`
 
+	// Order of results by round and position in the block:
+	// * asc - oldest first, the default unless filtering by address
+	// * desc - newest first, the default when filtering by address
+	//
+	// The order is kept in the next token, so it only needs to be given on the first request.
+	Order *string `json:"order,omitempty"`
+
 	// Specifies a prefix which must be contained in the note field.
 	NotePrefix *string `json:"note-prefix,omitempty"`
 	TxType     *string `json:"tx-type,omitempty"`
@@ -830,7 +1279,7 @@ type LookupAssetTransactionsParams struct {
 	// Only include transactions with this address in one of the transaction fields.
 	Address *string `json:"address,omitempty"`
 
-	// Combine with the address parameter to define what type of address to search for.
+	// Combine with the address parameter to define what type of address to search for. The asset-* roles are the addresses set by an asset config transaction, and rekey-to is the new authorizing address of a rekey.
 	AddressRole *string `json:"address-role,omitempty"`
 
 	// Combine with address and address-role parameters to define what type of address to search for. The close to fields are normally treated as a receiver, if you would like to exclude them set this parameter to true.
@@ -840,6 +1289,66 @@ type LookupAssetTransactionsParams struct {
 	RekeyTo *bool `json:"rekey-to,omitempty"`
 }
 
+// LookupBlockParams defines parameters for LookupBlock.
+type LookupBlockParams struct {
+
+	// How much of the block's transactions to include:
+	// * full - (default) every transaction
+	// * ids - only the transaction IDs, in transaction-ids
+	// * none - only the block header
+	Transactions *string `json:"transactions,omitempty"`
+}
+
+// LookupStatsParams defines parameters for LookupStats.
+type LookupStatsParams struct {
+
+	// Length of the totals:
+	// * round - one round
+	// * hour - an hour, in UTC
+	// * day - (default) a day, in UTC
+	Interval *string `json:"interval,omitempty"`
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Include results after the given time. Must be an RFC 3339 formatted string.
+	AfterTime *time.Time `json:"after-time,omitempty"`
+
+	// Include results before the given time. Must be an RFC 3339 formatted string.
+	BeforeTime *time.Time `json:"before-time,omitempty"`
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+}
+
+// LookupAssetStatsParams defines parameters for LookupAssetStats.
+type LookupAssetStatsParams struct {
+
+	// Length of the totals:
+	// * round - one round
+	// * hour - an hour, in UTC
+	// * day - (default) a day, in UTC
+	Interval *string `json:"interval,omitempty"`
+
+	// Include results at or after the specified min-round.
+	MinRound *uint64 `json:"min-round,omitempty"`
+
+	// Include results at or before the specified max-round.
+	MaxRound *uint64 `json:"max-round,omitempty"`
+
+	// Include results after the given time. Must be an RFC 3339 formatted string.
+	AfterTime *time.Time `json:"after-time,omitempty"`
+
+	// Include results before the given time. Must be an RFC 3339 formatted string.
+	BeforeTime *time.Time `json:"before-time,omitempty"`
+
+	// Maximum number of results to return.
+	Limit *uint64 `json:"limit,omitempty"`
+}
+
 // SearchForTransactionsParams defines parameters for SearchForTransactions.
 type SearchForTransactionsParams struct {
 
@@ -849,6 +1358,13 @@ type SearchForTransactionsParams struct {
 	// The next page of results. Use the next token provided by the previous results.
 	Next *string `json:"next,omitempty"`
 
+	// Order of results by round and position in the block:
+	// * asc - oldest first, the default unless filtering by address
+	// * desc - newest first, the default when filtering by address
+	//
+	// The order is kept in the next token, so it only needs to be given on the first request.
+	Order *string `json:"order,omitempty"`
+
 	// Specifies a prefix which must be contained in the note field.
 	NotePrefix *string `json:"note-prefix,omitempty"`
 	TxType     *string `json:"tx-type,omitempty"`
@@ -889,7 +1405,7 @@ type SearchForTransactionsParams struct {
 	// Only include transactions with this address in one of the transaction fields.
 	Address *string `json:"address,omitempty"`
 
-	// Combine with the address parameter to define what type of address to search for.
+	// Combine with the address parameter to define what type of address to search for. The asset-* roles are the addresses set by an asset config transaction, and rekey-to is the new authorizing address of a rekey.
 	AddressRole *string `json:"address-role,omitempty"`
 
 	// Combine with address and address-role parameters to define what type of address to search for. The close to fields are normally treated as a receiver, if you would like to exclude them set this parameter to true.
@@ -897,4 +1413,20 @@ type SearchForTransactionsParams struct {
 
 	// Include results which include the rekey-to field.
 	RekeyTo *bool `json:"rekey-to,omitempty"`
+
+	// Include results in this transaction group, encoded as base64 in the standard or URL-safe alphabet.
+	GroupId *string `json:"group-id,omitempty"`
+
+	// Include results signed by a logic sig with this program hash, encoded as base64 in the standard or URL-safe alphabet. The program hash is the same 32 bytes as the address of an escrow account for the program.
+	LsigProgramHash *string `json:"lsig-program-hash,omitempty"`
+
+	// Include results signed by a multisig that has this public key as one of its participants. The public key is given as its single signature address.
+	MsigParticipant *string `json:"msig-participant,omitempty"`
+}
+
+// LookupTransactionParams defines parameters for LookupTransaction.
+type LookupTransactionParams struct {
+
+	// Only look in the specified round.
+	Round *uint64 `json:"round,omitempty"`
 }
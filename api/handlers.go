@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -26,6 +27,10 @@ type ServerImplementation struct {
 	// is from long ago).
 	EnableAddressSearchRoundRewind bool
 
+	// NextTokenKey, if set, is used to sign next tokens so that
+	// clients can't construct their own.
+	NextTokenKey []byte
+
 	db idb.IndexerDb
 
 	log *log.Logger
@@ -39,6 +44,11 @@ type ServerImplementation struct {
 const maxTransactionsLimit = 10000
 const defaultTransactionsLimit = 1000
 
+// A txid is only unique within the validity window of its
+// transaction, this many rounds with the same txid are listed for a
+// client to choose between.
+const maxTxidMatches = 10
+
 // Accounts
 const maxAccountsLimit = 1000
 const defaultAccountsLimit = 100
@@ -51,6 +61,14 @@ const defaultAssetsLimit = 100
 const maxBalancesLimit = 10000
 const defaultBalancesLimit = 1000
 
+// Asset supply at an earlier round is rewound in memory from every
+// holding of the asset, which is refused for assets with more than this.
+const maxSupplyRewindHoldings = 10000
+
+// Stats
+const maxStatsLimit = 1000
+const defaultStatsLimit = 100
+
 ////////////////////////////
 // Handler implementation //
 ////////////////////////////
@@ -66,7 +84,16 @@ func (si *ServerImplementation) MakeHealthCheck(ctx echo.Context) error {
 			return indexerError(ctx, fmt.Sprintf("error parsing import state: %v", err))
 		}
 	}
+	earliest, err := si.earliestRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+	var data *map[string]interface{}
+	if earliest != nil {
+		data = &map[string]interface{}{"earliest-round": *earliest}
+	}
 	return ctx.JSON(http.StatusOK, common.HealthCheckResponse{
+		Data:    data,
 		Message: strconv.FormatInt(state.AccountRound, 10),
 	})
 }
@@ -79,10 +106,22 @@ func (si *ServerImplementation) LookupAccountByID(ctx echo.Context, accountID st
 		return badRequest(ctx, errors[0])
 	}
 
+	if params.Round != nil {
+		earliest, err := si.earliestRound()
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		if beforeEarliest(earliest, *params.Round) {
+			return badRequest(ctx, fmt.Sprintf("%s: %d", errTransactionsPruned, *earliest))
+		}
+	}
+
+	// a closed account is still found by its address, flagged deleted
 	options := idb.AccountQueryOptions{
 		EqualToAddress:       addr[:],
 		IncludeAssetHoldings: true,
 		IncludeAssetParams:   true,
+		IncludeClosed:        true,
 		Limit:                1,
 	}
 
@@ -117,8 +156,18 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 	if !si.EnableAddressSearchRoundRewind && params.Round != nil {
 		return badRequest(ctx, errMultiAcctRewind)
 	}
+	if params.Round != nil {
+		earliest, err := si.earliestRound()
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		if beforeEarliest(earliest, *params.Round) {
+			return badRequest(ctx, fmt.Sprintf("%s: %d", errTransactionsPruned, *earliest))
+		}
+	}
 
 	spendingAddr, errors := decodeAddress(params.AuthAddr, "account-id", make([]string, 0))
+	everAuthAddr, errors := decodeAddress(params.EverAuthAddr, "ever-auth-addr", errors)
 	if len(errors) != 0 {
 		return badRequest(ctx, errors[0])
 	}
@@ -129,7 +178,30 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 		Limit:                min(uintOrDefaultValue(params.Limit, defaultAccountsLimit), maxAccountsLimit),
 		HasAssetId:           uintOrDefault(params.AssetId),
 		EqualToAuthAddr:      spendingAddr[:],
+		EverAuthAddr:         everAuthAddr[:],
+		IncludeClosed:        boolOrDefault(params.IncludeClosed),
+		ActiveSince:          uintOrDefault(params.ActiveSince),
+	}
+
+	switch strOrDefault(params.Order) {
+	case "", "address":
+	case "balance-desc":
+		if params.Round != nil {
+			return badRequest(ctx, errOrderWithRound)
+		}
+		options.OrderByBalance = true
+	default:
+		return badRequest(ctx, errUnknownAccountOrder)
+	}
+
+	if params.Status != nil {
+		status, ok := AccountStatusEnumMap[*params.Status]
+		if !ok {
+			return badRequest(ctx, errUnknownAccountStatus)
+		}
+		options.Status = &status
 	}
+	options.VoteLastBefore = uintOrDefault(params.ParticipationExpiresBefore)
 
 	// Set GT/LT on Algos or Asset depending on whether or not an assetID was specified
 	if options.HasAssetId == 0 {
@@ -141,11 +213,20 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 	}
 
 	if params.Next != nil {
-		addr, err := types.DecodeAddress(*params.Next)
+		payload, err := si.decodeNext(*params.Next, nextTokenAccounts, makeAccountsNextFilter(options, params.Round))
+		if err != nil {
+			return badRequest(ctx, err.Error())
+		}
+		balance, addr, err := parseBalanceNextPayload(options.OrderByBalance, payload)
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, errUnableToParseNext)
+			return badRequest(ctx, err.Error())
+		}
+		if options.OrderByBalance {
+			options.BalanceBefore = balance
+			options.AddressBefore = addr
+		} else {
+			options.GreaterThanAddress = addr
 		}
-		options.GreaterThanAddress = addr[:]
 	}
 
 	accounts, err := si.fetchAccounts(ctx.Request().Context(), options, params.Round)
@@ -161,8 +242,18 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 
 	// Set the next token if we hit the results limit
 	var next *string
-	if params.Limit != nil && uint64(len(accounts)) >= *params.Limit {
-		next = strPtr(accounts[len(accounts)-1].Address)
+	if options.Limit != 0 && uint64(len(accounts)) >= options.Limit {
+		last := accounts[len(accounts)-1]
+		addr, err := types.DecodeAddress(last.Address)
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		payload := balanceNextPayload(options.OrderByBalance, last.AmountWithoutPendingRewards, addr[:])
+		token, err := si.encodeNext(nextTokenAccounts, makeAccountsNextFilter(options, params.Round), payload)
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		next = &token
 	}
 
 	response := generated.AccountsResponse{
@@ -174,6 +265,131 @@ func (si *ServerImplementation) SearchForAccounts(ctx echo.Context, params gener
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// LookupAccountAuthHistory looks up the rekeys of an account.
+// (GET /v2/accounts/{account-id}/auth-history)
+func (si *ServerImplementation) LookupAccountAuthHistory(ctx echo.Context, accountID string) error {
+	addr, errors := decodeAddress(&accountID, "account-id", make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
+	history, err := si.fetchAuthHistory(ctx.Request().Context(), addr)
+	return si.historyResponse(ctx, err, func(round uint64) interface{} {
+		return generated.AuthHistoryResponse{
+			CurrentRound: round,
+			AuthHistory:  history,
+		}
+	})
+}
+
+// LookupAccountMultisigs looks up the multisig addresses an account's public key participates in.
+// (GET /v2/accounts/{account-id}/multisigs)
+func (si *ServerImplementation) LookupAccountMultisigs(ctx echo.Context, accountID string) error {
+	pubkey, errors := decodeAddress(&accountID, "account-id", make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
+	multisigs, err := si.fetchMultisigAccounts(ctx.Request().Context(), pubkey)
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	round, err := si.db.GetMaxRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, generated.MultisigAccountsResponse{
+		CurrentRound: round,
+		Multisigs:    multisigs,
+	})
+}
+
+// LookupAccountParticipationHistory looks up the keyregs of an account.
+// (GET /v2/accounts/{account-id}/participation-history)
+func (si *ServerImplementation) LookupAccountParticipationHistory(ctx echo.Context, accountID string) error {
+	addr, errors := decodeAddress(&accountID, "account-id", make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
+	history, err := si.fetchParticipationHistory(ctx.Request().Context(), addr)
+	return si.historyResponse(ctx, err, func(round uint64) interface{} {
+		return generated.ParticipationHistoryResponse{
+			CurrentRound:         round,
+			ParticipationHistory: history,
+		}
+	})
+}
+
+// LookupAccountRewards looks up the rewards of an account and how they are computed.
+// (GET /v2/accounts/{account-id}/rewards)
+func (si *ServerImplementation) LookupAccountRewards(ctx echo.Context, accountID string, params generated.LookupAccountRewardsParams) error {
+	addr, errors := decodeAddress(&accountID, "account-id", make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
+	if params.Round != nil {
+		earliest, err := si.earliestRound()
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		if beforeEarliest(earliest, *params.Round) {
+			return badRequest(ctx, fmt.Sprintf("%s: %d", errTransactionsPruned, *earliest))
+		}
+	}
+
+	options := idb.AccountQueryOptions{
+		EqualToAddress: addr[:],
+		Limit:          1,
+	}
+
+	accounts, err := si.fetchAccounts(ctx.Request().Context(), options, params.Round)
+	if err != nil {
+		return indexerError(ctx, fmt.Sprintf("%s: %v", errFailedSearchingAccount, err))
+	}
+
+	if len(accounts) == 0 {
+		return notFound(ctx, fmt.Sprintf("%s: %s", errNoAccountsFound, accountID))
+	}
+	account := accounts[0]
+
+	block, err := si.db.GetBlock(account.Round)
+	if err != nil {
+		return indexerError(ctx, fmt.Sprintf("%s '%d': %v", errLookingUpBlock, account.Round, err))
+	}
+	proto, err := si.db.GetProto(string(block.CurrentProtocol))
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	rewards := generated.AccountRewards{
+		Round:                       account.Round,
+		AmountWithoutPendingRewards: account.AmountWithoutPendingRewards,
+		RewardBase:                  uintOrDefault(account.RewardBase),
+		RewardsLevel:                block.RewardsLevel,
+		RewardUnit:                  proto.RewardUnit,
+		PendingRewards:              account.PendingRewards,
+		CreditedRewards:             account.Rewards - account.PendingRewards,
+		Rewards:                     account.Rewards,
+	}
+	if proto.RewardUnit != 0 {
+		rewards.RewardUnits = account.AmountWithoutPendingRewards / proto.RewardUnit
+	}
+
+	round, err := si.db.GetMaxRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, generated.AccountRewardsResponse{
+		CurrentRound: round,
+		Rewards:      rewards,
+	})
+}
+
 // LookupAccountTransactions looks up transactions associated with a particular account.
 // (GET /v2/accounts/{account-id}/transactions)
 func (si *ServerImplementation) LookupAccountTransactions(ctx echo.Context, accountID string, params generated.LookupAccountTransactionsParams) error {
@@ -203,6 +419,7 @@ func (si *ServerImplementation) LookupAccountTransactions(ctx echo.Context, acco
 		CurrencyGreaterThan: params.CurrencyGreaterThan,
 		CurrencyLessThan:    params.CurrencyLessThan,
 		RekeyTo:             params.RekeyTo,
+		Order:               params.Order,
 	}
 
 	return si.SearchForTransactions(ctx, searchParams)
@@ -219,6 +436,8 @@ func (si *ServerImplementation) LookupAssetByID(ctx echo.Context, assetID uint64
 	if err != nil {
 		return badRequest(ctx, err.Error())
 	}
+	// a destroyed asset is returned with the params it last had
+	options.IncludeDeleted = true
 
 	assets, err := si.fetchAssets(ctx.Request().Context(), options)
 	if err != nil {
@@ -244,6 +463,82 @@ func (si *ServerImplementation) LookupAssetByID(ctx echo.Context, assetID uint64
 	})
 }
 
+// LookupAssetConfigHistory looks up the acfgs of an asset.
+// (GET /v2/assets/{asset-id}/config-history)
+func (si *ServerImplementation) LookupAssetConfigHistory(ctx echo.Context, assetID uint64) error {
+	history, err := si.fetchAssetConfigHistory(ctx.Request().Context(), assetID)
+	return si.historyResponse(ctx, err, func(round uint64) interface{} {
+		return generated.AssetConfigHistoryResponse{
+			CurrentRound:  round,
+			ConfigHistory: history,
+		}
+	})
+}
+
+// LookupAssetSupply looks up how the units of an asset are held.
+// (GET /v2/assets/{asset-id}/supply)
+func (si *ServerImplementation) LookupAssetSupply(ctx echo.Context, assetID uint64, params generated.LookupAssetSupplyParams) error {
+	if params.Round != nil {
+		earliest, err := si.earliestRound()
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		if beforeEarliest(earliest, *params.Round) {
+			return badRequest(ctx, fmt.Sprintf("%s: %d", errTransactionsPruned, *earliest))
+		}
+	}
+
+	var asset *idb.AssetRow
+	options := idb.AssetsQuery{AssetId: assetID, IncludeDeleted: true, Limit: 1}
+	for row := range si.db.Assets(ctx.Request().Context(), options) {
+		if row.Error != nil {
+			return indexerError(ctx, row.Error.Error())
+		}
+		asset = new(idb.AssetRow)
+		*asset = row
+	}
+	if asset == nil {
+		return notFound(ctx, fmt.Sprintf("%s: %d", errNoAssetsFound, assetID))
+	}
+
+	supply, err := si.db.AssetSupply(ctx.Request().Context(), assetID)
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+	if params.Round != nil && *params.Round < supply.Round {
+		if supply.Holders+supply.ZeroBalance > maxSupplyRewindHoldings {
+			return badRequest(ctx, fmt.Sprintf("%s: %d", errSupplyRewindTooLarge, maxSupplyRewindHoldings))
+		}
+		var ok bool
+		supply, ok, err = accounting.AssetSupplyAtRound(*asset, supply, *params.Round, si.db)
+		if err != nil {
+			return indexerError(ctx, fmt.Sprintf("%s: %v", errRewindingAsset, err))
+		}
+		if !ok {
+			return notFound(ctx, fmt.Sprintf("%s: %d", errNoAssetsFound, assetID))
+		}
+	}
+
+	round, err := si.db.GetMaxRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, generated.AssetSupplyResponse{
+		CurrentRound: round,
+		Supply: generated.AssetSupply{
+			AssetId:            assetID,
+			Round:              supply.Round,
+			Total:              supply.Total,
+			ReserveAmount:      supply.Reserve,
+			CreatorAmount:      supply.Creator,
+			FrozenAmount:       supply.Frozen,
+			Holders:            supply.Holders,
+			ZeroBalanceHolders: supply.ZeroBalance,
+		},
+	})
+}
+
 // LookupAssetBalances looks up balances for a particular asset
 // (GET /v2/assets/{asset-id}/balances)
 func (si *ServerImplementation) LookupAssetBalances(ctx echo.Context, assetID uint64, params generated.LookupAssetBalancesParams) error {
@@ -252,19 +547,32 @@ func (si *ServerImplementation) LookupAssetBalances(ctx echo.Context, assetID ui
 		AmountGT: uintOrDefault(params.CurrencyGreaterThan),
 		AmountLT: uintOrDefault(params.CurrencyLessThan),
 		Limit:    min(uintOrDefaultValue(params.Limit, defaultBalancesLimit), maxBalancesLimit),
+
+		IncludeClosed: boolOrDefault(params.IncludeClosed),
+	}
+
+	switch strOrDefault(params.Order) {
+	case "", "address":
+	case "amount-desc":
+		query.OrderByAmount = true
+	default:
+		return badRequest(ctx, errUnknownBalanceOrder)
 	}
 
 	if params.Next != nil {
-		addr, err := types.DecodeAddress(*params.Next)
+		payload, err := si.decodeNext(*params.Next, nextTokenBalances, balancesNextFilter(query))
 		if err != nil {
-			ctx.JSON(http.StatusBadRequest, errUnableToParseNext)
+			return badRequest(ctx, err.Error())
+		}
+		query.PrevAmount, query.PrevAddress, err = parseBalanceNextPayload(query.OrderByAmount, payload)
+		if err != nil {
+			return badRequest(ctx, err.Error())
 		}
-		query.PrevAddress = addr[:]
 	}
 
 	balances, err := si.fetchAssetBalances(ctx.Request().Context(), query)
 	if err != nil {
-		indexerError(ctx, err.Error())
+		return indexerError(ctx, err.Error())
 	}
 
 	round, err := si.db.GetMaxRound()
@@ -274,8 +582,17 @@ func (si *ServerImplementation) LookupAssetBalances(ctx echo.Context, assetID ui
 
 	// Set the next token if we hit the results limit
 	var next *string
-	if params.Limit != nil && uint64(len(balances)) >= *params.Limit {
-		next = strPtr(balances[len(balances)-1].Address)
+	if query.Limit != 0 && uint64(len(balances)) >= query.Limit {
+		last := balances[len(balances)-1]
+		addr, err := types.DecodeAddress(last.Address)
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		token, err := si.encodeNext(nextTokenBalances, balancesNextFilter(query), balanceNextPayload(query.OrderByAmount, last.Amount, addr[:]))
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		next = &token
 	}
 
 	return ctx.JSON(http.StatusOK, generated.AssetBalancesResponse{
@@ -303,10 +620,11 @@ func (si *ServerImplementation) LookupAssetTransactions(ctx echo.Context, assetI
 		AfterTime:           params.AfterTime,
 		CurrencyGreaterThan: params.CurrencyGreaterThan,
 		CurrencyLessThan:    params.CurrencyLessThan,
-		Address:             params.AddressRole,
+		Address:             params.Address,
 		AddressRole:         params.AddressRole,
 		ExcludeCloseTo:      params.ExcludeCloseTo,
 		RekeyTo:             params.RekeyTo,
+		Order:               params.Order,
 	}
 
 	return si.SearchForTransactions(ctx, searchParams)
@@ -320,6 +638,17 @@ func (si *ServerImplementation) SearchForAssets(ctx echo.Context, params generat
 		return badRequest(ctx, err.Error())
 	}
 
+	if params.Next != nil {
+		payload, err := si.decodeNext(*params.Next, nextTokenAssets, assetsNextFilter(options))
+		if err != nil {
+			return badRequest(ctx, err.Error())
+		}
+		if len(payload) != 8 {
+			return badRequest(ctx, errUnableToParseNext)
+		}
+		options.AssetIdGreaterThan = binary.LittleEndian.Uint64(payload)
+	}
+
 	assets, err := si.fetchAssets(ctx.Request().Context(), options)
 	if err != nil {
 		return indexerError(ctx, err.Error())
@@ -330,10 +659,16 @@ func (si *ServerImplementation) SearchForAssets(ctx echo.Context, params generat
 		return indexerError(ctx, err.Error())
 	}
 
-	// Set the next token if we hit the results limit
+	// Set the next token if we hit the results limit, relevance order is only one page
 	var next *string
-	if params.Limit != nil && uint64(len(assets)) >= *params.Limit {
-		next = strPtr(strconv.FormatUint(assets[len(assets)-1].Index, 10))
+	if !options.OrderByRelevance && options.Limit != 0 && uint64(len(assets)) >= options.Limit {
+		var payload [8]byte
+		binary.LittleEndian.PutUint64(payload[:], assets[len(assets)-1].Index)
+		token, err := si.encodeNext(nextTokenAssets, assetsNextFilter(options), payload[:])
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		next = &token
 	}
 
 	return ctx.JSON(http.StatusOK, generated.AssetsResponse{
@@ -345,12 +680,31 @@ func (si *ServerImplementation) SearchForAssets(ctx echo.Context, params generat
 
 // LookupBlock returns the block for a given round number
 // (GET /v2/blocks/{round-number})
-func (si *ServerImplementation) LookupBlock(ctx echo.Context, roundNumber uint64) error {
+func (si *ServerImplementation) LookupBlock(ctx echo.Context, roundNumber uint64, params generated.LookupBlockParams) error {
+	mode := strOrDefault(params.Transactions)
+	if mode != "" && mode != "full" && mode != "ids" && mode != "none" {
+		return badRequest(ctx, errUnknownBlockTransactions)
+	}
+
+	if mode != "none" {
+		earliest, err := si.earliestRound()
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		if beforeEarliest(earliest, roundNumber) {
+			return badRequest(ctx, fmt.Sprintf("%s: %d", errTransactionsPruned, *earliest))
+		}
+	}
+
 	blk, err := si.fetchBlock(roundNumber)
 	if err != nil {
 		return indexerError(ctx, err.Error())
 	}
 
+	if mode == "none" {
+		return ctx.JSON(http.StatusOK, generated.BlockResponse(blk))
+	}
+
 	// Lookup transactions
 	filter := idb.TransactionFilter{Round: uint64Ptr(roundNumber)}
 	txns, _, err := si.fetchTransactions(ctx.Request().Context(), filter)
@@ -358,10 +712,193 @@ func (si *ServerImplementation) LookupBlock(ctx echo.Context, roundNumber uint64
 		return indexerError(ctx, fmt.Sprintf("%s for round '%d': %v", errTransactionSearch, roundNumber, err))
 	}
 
-	blk.Transactions = &txns
+	if mode == "ids" {
+		txids := make([]string, len(txns))
+		for i, txn := range txns {
+			txids[i] = txn.Id
+		}
+		blk.TransactionIds = &txids
+	} else {
+		blk.Transactions = &txns
+	}
 	return ctx.JSON(http.StatusOK, generated.BlockResponse(blk))
 }
 
+// LookupGroup returns the transactions in an atomic transfer group
+// (GET /v2/groups/{group-id})
+func (si *ServerImplementation) LookupGroup(ctx echo.Context, groupID string) error {
+	group, errors := decodeGroupID(&groupID, "group-id", make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
+	filter := idb.TransactionFilter{GroupId: group}
+	txns, _, err := si.fetchTransactions(ctx.Request().Context(), filter)
+	if err != nil {
+		return indexerError(ctx, fmt.Sprintf("%s: %v", errTransactionSearch, err))
+	}
+
+	if len(txns) == 0 {
+		return si.txnNotFound(ctx, fmt.Sprintf("%s group-id: %s", errNoTransactionsFound, groupID))
+	}
+
+	round, err := si.db.GetMaxRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, generated.TransactionsResponse{
+		CurrentRound: round,
+		Transactions: txns,
+	})
+}
+
+// LookupStake returns the total stake of accounts by participation status.
+// (GET /v2/stake)
+func (si *ServerImplementation) LookupStake(ctx echo.Context) error {
+	totals, err := si.db.StakeTotals(ctx.Request().Context())
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, generated.StakeResponse{
+		CurrentRound:          totals.Round,
+		OnlineMoney:           totals.Online,
+		OfflineMoney:          totals.Offline,
+		NotParticipatingMoney: totals.NotParticipating,
+	})
+}
+
+// LookupStats returns transaction totals by round, hour or day.
+// (GET /v2/stats)
+func (si *ServerImplementation) LookupStats(ctx echo.Context, params generated.LookupStatsParams) error {
+	query, err := statsParamsToStatsQuery(params)
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+
+	stats := make([]generated.Stats, 0)
+	for row := range si.db.Stats(ctx.Request().Context(), query) {
+		if row.Error != nil {
+			return indexerError(ctx, row.Error.Error())
+		}
+		stats = append(stats, generated.Stats{
+			FirstRound:     row.FirstRound,
+			LastRound:      row.LastRound,
+			StartTime:      uint64(row.Start.Unix()),
+			Txns:           row.Txns,
+			PayTxns:        row.TxnCounts["pay"],
+			KeyregTxns:     row.TxnCounts["keyreg"],
+			AcfgTxns:       row.TxnCounts["acfg"],
+			AxferTxns:      row.TxnCounts["axfer"],
+			AfrzTxns:       row.TxnCounts["afrz"],
+			AlgoVolume:     row.AlgoVolume,
+			Fees:           row.Fees,
+			NewAccounts:    row.NewAccounts,
+			ActiveAccounts: row.ActiveAccounts,
+		})
+	}
+
+	round, err := si.db.GetMaxRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, generated.StatsResponse{
+		CurrentRound: round,
+		Stats:        stats,
+	})
+}
+
+// LookupAssetStats returns transfer totals of an asset by round, hour or day.
+// (GET /v2/stats/assets/{asset-id})
+func (si *ServerImplementation) LookupAssetStats(ctx echo.Context, assetID uint64, params generated.LookupAssetStatsParams) error {
+	query, err := statsParamsToStatsQuery(generated.LookupStatsParams(params))
+	if err != nil {
+		return badRequest(ctx, err.Error())
+	}
+	query.AssetId = assetID
+
+	stats := make([]generated.AssetStats, 0)
+	for row := range si.db.AssetStats(ctx.Request().Context(), query) {
+		if row.Error != nil {
+			return indexerError(ctx, row.Error.Error())
+		}
+		stats = append(stats, generated.AssetStats{
+			FirstRound: row.FirstRound,
+			LastRound:  row.LastRound,
+			StartTime:  uint64(row.Start.Unix()),
+			Transfers:  row.Transfers,
+			Volume:     row.Volume,
+		})
+	}
+
+	round, err := si.db.GetMaxRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, generated.AssetStatsResponse{
+		CurrentRound: round,
+		AssetId:      assetID,
+		Stats:        stats,
+	})
+}
+
+// LookupTransaction returns the one confirmed transaction with a txid
+// (GET /v2/transactions/{txid})
+func (si *ServerImplementation) LookupTransaction(ctx echo.Context, txid string, params generated.LookupTransactionParams) error {
+	txid, errors := decodeDigest(&txid, "txid", make([]string, 0))
+	if len(errors) != 0 {
+		return badRequest(ctx, errors[0])
+	}
+
+	if params.Round != nil {
+		earliest, err := si.earliestRound()
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+		if beforeEarliest(earliest, *params.Round) {
+			return badRequest(ctx, fmt.Sprintf("%s: %d", errTransactionsPruned, *earliest))
+		}
+	}
+
+	filter := idb.TransactionFilter{
+		Txid:  txid,
+		Round: params.Round,
+		Limit: maxTxidMatches,
+	}
+	txns, _, err := si.fetchTransactions(ctx.Request().Context(), filter)
+	if err != nil {
+		return indexerError(ctx, fmt.Sprintf("%s: %v", errTransactionSearch, err))
+	}
+
+	if len(txns) == 0 {
+		return si.txnNotFound(ctx, fmt.Sprintf("%s txid: %s", errNoTransactionsFound, txid))
+	}
+
+	if len(txns) > 1 {
+		rounds := make([]uint64, len(txns))
+		for i, txn := range txns {
+			rounds[i] = uintOrDefault(txn.ConfirmedRound)
+		}
+		return ctx.JSON(http.StatusConflict, generated.ErrorResponse{
+			Message: fmt.Sprintf("%s: %s", errMultipleTransactions, txid),
+			Data:    &map[string]interface{}{"rounds": rounds},
+		})
+	}
+
+	round, err := si.db.GetMaxRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, generated.TransactionResponse{
+		CurrentRound: round,
+		Transaction:  txns[0],
+	})
+}
+
 // SearchForTransactions returns transactions matching the provided parameters
 // (GET /v2/transactions)
 func (si *ServerImplementation) SearchForTransactions(ctx echo.Context, params generated.SearchForTransactionsParams) error {
@@ -369,6 +906,20 @@ func (si *ServerImplementation) SearchForTransactions(ctx echo.Context, params g
 	if err != nil {
 		return badRequest(ctx, err.Error())
 	}
+	if filter.NextToken != "" {
+		err = si.decodeTxnNext(&filter)
+		if err != nil {
+			return badRequest(ctx, err.Error())
+		}
+	}
+
+	earliest, err := si.earliestRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+	if (filter.Round != nil && beforeEarliest(earliest, *filter.Round)) || (filter.MaxRound != 0 && beforeEarliest(earliest, filter.MaxRound)) {
+		return badRequest(ctx, fmt.Sprintf("%s: %d", errTransactionsPruned, *earliest))
+	}
 
 	// Fetch the transactions
 	txns, next, err := si.fetchTransactions(ctx.Request().Context(), filter)
@@ -376,6 +927,12 @@ func (si *ServerImplementation) SearchForTransactions(ctx echo.Context, params g
 	if err != nil {
 		return indexerError(ctx, fmt.Sprintf("%s: %v", errRewindingAccount, err))
 	}
+	if next != "" {
+		next, err = si.encodeNext(nextTokenTransactions, txnNextFilter(filter), []byte(next))
+		if err != nil {
+			return indexerError(ctx, err.Error())
+		}
+	}
 
 	round, err := si.db.GetMaxRound()
 	if err != nil {
@@ -383,9 +940,10 @@ func (si *ServerImplementation) SearchForTransactions(ctx echo.Context, params g
 	}
 
 	response := generated.TransactionsResponse{
-		CurrentRound: round,
-		NextToken:    strPtr(next),
-		Transactions: txns,
+		CurrentRound:  round,
+		EarliestRound: earliest,
+		NextToken:     strPtr(next),
+		Transactions:  txns,
 	}
 
 	return ctx.JSON(http.StatusOK, response)
@@ -420,6 +978,43 @@ func notFound(ctx echo.Context, err string) error {
 // IndexerDb helpers //
 ///////////////////////
 
+// earliestRound returns the oldest round which still has transactions, or nil
+// if the retention policy has never removed any.
+func (si *ServerImplementation) earliestRound() (*uint64, error) {
+	retentionJsonStr, err := si.db.GetMetastate("retention")
+	if err != nil {
+		return nil, fmt.Errorf("error getting retention state: %v", err)
+	}
+	if retentionJsonStr == "" {
+		return nil, nil
+	}
+	retention, err := idb.ParseRetentionState(retentionJsonStr)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing retention state: %v", err)
+	}
+	return uint64Ptr(retention.EarliestRound), nil
+}
+
+// txnNotFound responds 404 to a lookup by txid or group that found
+// nothing. When transactions have been pruned they may have been in an
+// earlier round, so the earliest round still available is in data.
+func (si *ServerImplementation) txnNotFound(ctx echo.Context, message string) error {
+	earliest, err := si.earliestRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+	response := generated.ErrorResponse{Message: message}
+	if earliest != nil {
+		response.Data = &map[string]interface{}{"earliest-round": *earliest}
+	}
+	return ctx.JSON(http.StatusNotFound, response)
+}
+
+// beforeEarliest is true if transactions for round have been pruned.
+func beforeEarliest(earliest *uint64, round uint64) bool {
+	return earliest != nil && round < *earliest
+}
+
 // fetchAssets fetches all results and converts them into generated.Asset objects
 func (si *ServerImplementation) fetchAssets(ctx context.Context, options idb.AssetsQuery) ([]generated.Asset, error) {
 	assetchan := si.db.Assets(ctx, options)
@@ -436,21 +1031,11 @@ func (si *ServerImplementation) fetchAssets(ctx context.Context, options idb.Ass
 		copy(creator[:], row.Creator[:])
 
 		asset := generated.Asset{
-			Index: row.AssetId,
-			Params: generated.AssetParams{
-				Creator:       creator.String(),
-				Name:          strPtr(row.Params.AssetName),
-				UnitName:      strPtr(row.Params.UnitName),
-				Url:           strPtr(row.Params.URL),
-				Total:         row.Params.Total,
-				Decimals:      uint64(row.Params.Decimals),
-				DefaultFrozen: boolPtr(row.Params.DefaultFrozen),
-				MetadataHash:  bytePtr(row.Params.MetadataHash[:]),
-				Clawback:      strPtr(row.Params.Clawback.String()),
-				Reserve:       strPtr(row.Params.Reserve.String()),
-				Freeze:        strPtr(row.Params.Freeze.String()),
-				Manager:       strPtr(row.Params.Manager.String()),
-			},
+			Index:            row.AssetId,
+			Params:           assetParams(creator, row.Params),
+			CreatedAtRound:   row.CreatedRound,
+			DestroyedAtRound: row.DestroyedRound,
+			Deleted:          boolPtr(row.Deleted),
 		}
 
 		assets = append(assets, asset)
@@ -475,9 +1060,12 @@ func (si *ServerImplementation) fetchAssetBalances(ctx context.Context, options
 		copy(addr[:], row.Address[:])
 
 		bal := generated.MiniAssetHolding{
-			Address:  addr.String(),
-			Amount:   row.Amount,
-			IsFrozen: row.Frozen,
+			Address:         addr.String(),
+			Amount:          row.Amount,
+			IsFrozen:        row.Frozen,
+			OptedInAtRound:  row.CreatedRound,
+			OptedOutAtRound: row.ClosedRound,
+			Deleted:         boolPtr(row.Deleted),
 		}
 
 		balances = append(balances, bal)
@@ -494,7 +1082,7 @@ func (si *ServerImplementation) fetchBlock(round uint64) (generated.Block, error
 	}
 
 	rewards := generated.BlockRewards{
-		FeeSink:                 "",
+		FeeSink:                 blk.FeeSink.String(),
 		RewardsCalculationRound: uint64(blk.RewardsRecalculationRound),
 		RewardsLevel:            blk.RewardsLevel,
 		RewardsPool:             blk.RewardsPool.String(),
@@ -563,6 +1151,144 @@ func (si *ServerImplementation) fetchAccounts(ctx context.Context, options idb.A
 	return accounts, nil
 }
 
+// assetParams converts the params of an asset created by creator.
+func assetParams(creator types.Address, params types.AssetParams) generated.AssetParams {
+	return generated.AssetParams{
+		Creator:       creator.String(),
+		Name:          strPtr(params.AssetName),
+		UnitName:      strPtr(params.UnitName),
+		Url:           strPtr(params.URL),
+		Total:         params.Total,
+		Decimals:      uint64(params.Decimals),
+		DefaultFrozen: boolPtr(params.DefaultFrozen),
+		MetadataHash:  bytePtr(params.MetadataHash[:]),
+		Clawback:      strPtr(params.Clawback.String()),
+		Reserve:       strPtr(params.Reserve.String()),
+		Freeze:        strPtr(params.Freeze.String()),
+		Manager:       strPtr(params.Manager.String()),
+	}
+}
+
+// historyResponse responds to a history lookup with the response
+// built for the current round, or with err from fetching the history.
+func (si *ServerImplementation) historyResponse(ctx echo.Context, err error, response func(round uint64) interface{}) error {
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	round, err := si.db.GetMaxRound()
+	if err != nil {
+		return indexerError(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, response(round))
+}
+
+// fetchAssetConfigHistory fetches the acfgs of an asset.
+func (si *ServerImplementation) fetchAssetConfigHistory(ctx context.Context, assetID uint64) ([]generated.AssetConfigChange, error) {
+	results := make([]generated.AssetConfigChange, 0)
+	for row := range si.db.AssetConfigHistory(ctx, assetID) {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		var sender, creator types.Address
+		copy(sender[:], row.Sender)
+		copy(creator[:], row.Creator)
+		change := generated.AssetConfigChange{
+			Round:     row.Round,
+			Txid:      row.Txid,
+			Sender:    sender.String(),
+			Destroyed: row.Params == nil,
+		}
+		if row.Params != nil {
+			params := assetParams(creator, *row.Params)
+			change.Params = &params
+		}
+		results = append(results, change)
+	}
+	return results, nil
+}
+
+// fetchAuthHistory fetches the rekeys of addr.
+func (si *ServerImplementation) fetchAuthHistory(ctx context.Context, addr []byte) ([]generated.AuthChange, error) {
+	results := make([]generated.AuthChange, 0)
+	for row := range si.db.AuthHistory(ctx, addr) {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		change := generated.AuthChange{
+			Round: row.Round,
+			Txid:  row.Txid,
+		}
+		if row.OldAuth != nil {
+			var old types.Address
+			copy(old[:], row.OldAuth)
+			change.OldAuthAddr = strPtr(old.String())
+		}
+		if row.NewAuth != nil {
+			var auth types.Address
+			copy(auth[:], row.NewAuth)
+			change.NewAuthAddr = strPtr(auth.String())
+		}
+		results = append(results, change)
+	}
+	return results, nil
+}
+
+// fetchMultisigAccounts fetches the multisig addresses pubkey participates in.
+func (si *ServerImplementation) fetchMultisigAccounts(ctx context.Context, pubkey []byte) ([]generated.MultisigAccount, error) {
+	results := make([]generated.MultisigAccount, 0)
+	for row := range si.db.MultisigAccounts(ctx, pubkey) {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		var addr types.Address
+		copy(addr[:], row.Address)
+		msig := generated.MultisigAccount{
+			Address:    addr.String(),
+			Version:    uint64(row.Version),
+			Threshold:  uint64(row.Threshold),
+			PublicKeys: make([]string, len(row.PublicKeys)),
+		}
+		for i, pk := range row.PublicKeys {
+			var pka types.Address
+			copy(pka[:], pk)
+			msig.PublicKeys[i] = pka.String()
+		}
+		results = append(results, msig)
+	}
+	return results, nil
+}
+
+// fetchParticipationHistory fetches the keyregs of addr.
+func (si *ServerImplementation) fetchParticipationHistory(ctx context.Context, addr []byte) ([]generated.ParticipationChange, error) {
+	results := make([]generated.ParticipationChange, 0)
+	for row := range si.db.ParticipationHistory(ctx, addr) {
+		if row.Error != nil {
+			return nil, row.Error
+		}
+		if row.Status < 0 || row.Status >= len(idb.StatusStrings) {
+			return nil, fmt.Errorf("unknown participation status %d", row.Status)
+		}
+		change := generated.ParticipationChange{
+			Round:  row.Round,
+			Txid:   row.Txid,
+			Status: idb.StatusStrings[row.Status],
+		}
+		if row.VoteKey != nil {
+			change.Participation = &generated.AccountParticipation{
+				VoteParticipationKey:      row.VoteKey,
+				SelectionParticipationKey: row.SelectionKey,
+				VoteFirstValid:            row.VoteFirst,
+				VoteLastValid:             row.VoteLast,
+				VoteKeyDilution:           row.VoteKeyDilution,
+			}
+		}
+		results = append(results, change)
+	}
+	return results, nil
+}
+
 // fetchTransactions is used to query the backend for transactions, and compute the next token
 func (si *ServerImplementation) fetchTransactions(ctx context.Context, filter idb.TransactionFilter) ([]generated.Transaction, string, error) {
 	results := make([]generated.Transaction, 0)
@@ -574,7 +1300,7 @@ func (si *ServerImplementation) fetchTransactions(ctx context.Context, filter id
 			return nil, "", err
 		}
 		results = append(results, tx)
-		nextToken = txrow.Next()
+		nextToken = txrow.Next(filter.Descending())
 	}
 
 	return results, nextToken, nil
@@ -1,20 +1,26 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/algorand/indexer/api/generated/v2"
 	"github.com/algorand/indexer/idb"
 	"github.com/algorand/indexer/idb/mocks"
+	"github.com/algorand/indexer/types"
 )
 
 func TestTransactionParamToTransactionFilter(t *testing.T) {
@@ -196,6 +202,42 @@ func TestTransactionParamToTransactionFilter(t *testing.T) {
 			filter:        idb.TransactionFilter{AlgosGT: 10, AlgosLT: 20, Limit: defaultTransactionsLimit},
 			errorContains: nil,
 		},
+		{
+			name:          "Group ID standard base64",
+			params:        generated.SearchForTransactionsParams{GroupId: strPtr("+/////////////////////////////////////////8=")},
+			filter:        idb.TransactionFilter{GroupId: append([]byte{0xfb}, bytes.Repeat([]byte{0xff}, 31)...), Limit: defaultTransactionsLimit},
+			errorContains: nil,
+		},
+		{
+			name:          "Group ID URL-safe base64",
+			params:        generated.SearchForTransactionsParams{GroupId: strPtr("-_________________________________________8=")},
+			filter:        idb.TransactionFilter{GroupId: append([]byte{0xfb}, bytes.Repeat([]byte{0xff}, 31)...), Limit: defaultTransactionsLimit},
+			errorContains: nil,
+		},
+		{
+			name:          "Group ID wrong length",
+			params:        generated.SearchForTransactionsParams{GroupId: strPtr("AAAA")},
+			filter:        idb.TransactionFilter{},
+			errorContains: []string{errUnableToParseGroupID},
+		},
+		{
+			name:          "Lsig program hash",
+			params:        generated.SearchForTransactionsParams{LsigProgramHash: strPtr("AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=")},
+			filter:        idb.TransactionFilter{LsigProgramHash: bytes.Repeat([]byte{1}, 32), Limit: defaultTransactionsLimit},
+			errorContains: nil,
+		},
+		{
+			name:          "Asset config role",
+			params:        generated.SearchForTransactionsParams{AddressRole: strPtr("asset-clawback")},
+			filter:        idb.TransactionFilter{AddressRole: idb.AddressRoleConfigClawback, Limit: defaultTransactionsLimit},
+			errorContains: nil,
+		},
+		{
+			name:          "Order descending",
+			params:        generated.SearchForTransactionsParams{Order: strPtr("desc")},
+			filter:        idb.TransactionFilter{Order: idb.TxnOrderDesc, Limit: defaultTransactionsLimit},
+			errorContains: nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -357,3 +399,645 @@ func TestFetchTransactions(t *testing.T) {
 		})
 	}
 }
+
+// newMockServer returns a ServerImplementation on a mock db.
+func newMockServer() (*mocks.IndexerDb, *ServerImplementation) {
+	mockIndexer := &mocks.IndexerDb{}
+	return mockIndexer, &ServerImplementation{db: mockIndexer}
+}
+
+// callHandler calls handler with a new request context and checks the
+// status code it responds with. The response body is decoded into
+// response unless it is nil, and returned.
+func callHandler(t *testing.T, handler func(c echo.Context) error, code int, response interface{}) string {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	assert.NoError(t, handler(c))
+	assert.Equal(t, code, rec.Code)
+	if response != nil {
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), response))
+	}
+	return rec.Body.String()
+}
+
+// txnRows and the functions like it below return rows for the mock db
+// to yield, on a closed channel.
+func txnRows(rows ...idb.TxnRow) <-chan idb.TxnRow {
+	ch := make(chan idb.TxnRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func assetRows(rows ...idb.AssetRow) <-chan idb.AssetRow {
+	ch := make(chan idb.AssetRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func assetBalanceRows(rows ...idb.AssetBalanceRow) <-chan idb.AssetBalanceRow {
+	ch := make(chan idb.AssetBalanceRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func assetConfigRows(rows ...idb.AssetConfigHistoryRow) <-chan idb.AssetConfigHistoryRow {
+	ch := make(chan idb.AssetConfigHistoryRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func authHistoryRows(rows ...idb.AuthHistoryRow) <-chan idb.AuthHistoryRow {
+	ch := make(chan idb.AuthHistoryRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func participationHistoryRows(rows ...idb.ParticipationHistoryRow) <-chan idb.ParticipationHistoryRow {
+	ch := make(chan idb.ParticipationHistoryRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func multisigRows(rows ...idb.MultisigAccountRow) <-chan idb.MultisigAccountRow {
+	ch := make(chan idb.MultisigAccountRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func accountRows(rows ...idb.AccountRow) <-chan idb.AccountRow {
+	ch := make(chan idb.AccountRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func statsRows(rows ...idb.StatsRow) <-chan idb.StatsRow {
+	ch := make(chan idb.StatsRow, len(rows))
+	for _, row := range rows {
+		ch <- row
+	}
+	close(ch)
+	return ch
+}
+
+func TestLookupBlockTransactionIds(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var block types.Block
+	block.Round = 7
+	block.FeeSink[0] = 1
+	outCh := txnRows(idb.TxnRow{
+		Round:     7,
+		RoundTime: time.Now(),
+		TxnBytes:  loadResourceFileOrPanic("test_resources/payment.txn"),
+	})
+	mockIndexer.On("GetMetastate", "retention").Return("", nil)
+	mockIndexer.On("GetBlock", uint64(7)).Return(block, nil)
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(outCh)
+
+	var response generated.BlockResponse
+	callHandler(t, func(c echo.Context) error {
+		return si.LookupBlock(c, 7, generated.LookupBlockParams{Transactions: strPtr("ids")})
+	}, http.StatusOK, &response)
+	assert.Nil(t, response.Transactions)
+	expected := loadTransactionFromFile("test_resources/payment.response")
+	assert.Equal(t, []string{expected.Id}, *response.TransactionIds)
+	assert.Equal(t, block.FeeSink.String(), response.Rewards.FeeSink)
+}
+
+func TestLookupTransactionMultipleRounds(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	txnBytes := loadResourceFileOrPanic("test_resources/payment.txn")
+	outCh := txnRows(
+		idb.TxnRow{Round: 5, RoundTime: time.Now(), TxnBytes: txnBytes},
+		idb.TxnRow{Round: 1005, RoundTime: time.Now(), TxnBytes: txnBytes},
+	)
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(outCh)
+
+	txid := loadTransactionFromFile("test_resources/payment.response").Id
+	var response generated.ErrorResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupTransaction(c, txid, generated.LookupTransactionParams{}) }, http.StatusConflict, &response)
+	assert.Contains(t, response.Message, errMultipleTransactions)
+	assert.Equal(t, []interface{}{float64(5), float64(1005)}, (*response.Data)["rounds"])
+}
+
+func TestLookupTransactionPruned(t *testing.T) {
+	mockIndexer, si := newMockServer()
+	mockIndexer.On("GetMetastate", "retention").Return(`{"earliest_round":100}`, nil)
+
+	txid := loadTransactionFromFile("test_resources/payment.response").Id
+	var response generated.ErrorResponse
+	callHandler(t, func(c echo.Context) error {
+		return si.LookupTransaction(c, txid, generated.LookupTransactionParams{Round: uint64Ptr(5)})
+	}, http.StatusBadRequest, &response)
+	assert.Equal(t, fmt.Sprintf("%s: 100", errTransactionsPruned), response.Message)
+	mockIndexer.AssertNotCalled(t, "Transactions", mock.Anything, mock.Anything)
+}
+
+func TestLookupGroupNotFoundAfterPruning(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	outCh := txnRows()
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(outCh)
+	mockIndexer.On("GetMetastate", "retention").Return(`{"earliest_round":100}`, nil)
+
+	group := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	var response generated.ErrorResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupGroup(c, group) }, http.StatusNotFound, &response)
+	assert.Contains(t, response.Message, errNoTransactionsFound)
+	assert.Equal(t, float64(100), (*response.Data)["earliest-round"])
+}
+
+func TestLookupTransactionNotFound(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	outCh := txnRows()
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(outCh)
+	mockIndexer.On("GetMetastate", "retention").Return("", nil)
+
+	txid := loadTransactionFromFile("test_resources/payment.response").Id
+	var response generated.ErrorResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupTransaction(c, txid, generated.LookupTransactionParams{}) }, http.StatusNotFound, &response)
+	assert.Contains(t, response.Message, errNoTransactionsFound)
+	assert.Nil(t, response.Data)
+}
+
+func TestLookupAssetBalancesOrderByAmount(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var addr types.Address
+	addr[0] = 1
+	outCh := assetBalanceRows(
+		idb.AssetBalanceRow{Address: make([]byte, 32), AssetId: 7, Amount: 100},
+		idb.AssetBalanceRow{Address: addr[:], AssetId: 7, Amount: 50},
+	)
+	mockIndexer.On("AssetBalances", mock.Anything, mock.MatchedBy(func(abq idb.AssetBalanceQuery) bool {
+		return abq.OrderByAmount && abq.PrevAmount == 200 && bytes.Equal(abq.PrevAddress, addr[:])
+	})).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	params := generated.LookupAssetBalancesParams{
+		Limit: uint64Ptr(2),
+		Order: strPtr("amount-desc"),
+	}
+	query := idb.AssetBalanceQuery{AssetId: 7, OrderByAmount: true}
+	token, err := si.encodeNext(nextTokenBalances, query, balanceNextPayload(true, 200, addr[:]))
+	assert.NoError(t, err)
+	params.Next = &token
+
+	var response generated.AssetBalancesResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupAssetBalances(c, 7, params) }, http.StatusOK, &response)
+	assert.Len(t, response.Balances, 2)
+	payload, err := si.decodeNext(*response.NextToken, nextTokenBalances, query)
+	assert.NoError(t, err)
+	amount, nextAddr, err := parseBalanceNextPayload(true, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(50), amount)
+	assert.Equal(t, addr[:], nextAddr)
+}
+
+func TestSearchForTransactionsBadNext(t *testing.T) {
+	_, si := newMockServer()
+	si.NextTokenKey = []byte("secret")
+
+	filter := idb.TransactionFilter{AssetId: 7}
+	txnNext := []byte(idb.TxnRow{Round: 1, Intra: 2}.Next(false))
+	good, err := si.encodeNext(nextTokenTransactions, filter, txnNext)
+	assert.NoError(t, err)
+	unsigned, err := (&ServerImplementation{}).encodeNext(nextTokenTransactions, filter, txnNext)
+	assert.NoError(t, err)
+	// the idb token without the order byte
+	legacy, err := si.encodeNext(nextTokenTransactions, filter, []byte(base64.URLEncoding.EncodeToString(make([]byte, 12))))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		params        generated.SearchForTransactionsParams
+		errorContains string
+	}{
+		{"Short", generated.SearchForTransactionsParams{AssetId: uint64Ptr(7), Next: strPtr("AAAA")}, errUnableToParseNext},
+		{"Unsigned", generated.SearchForTransactionsParams{AssetId: uint64Ptr(7), Next: &unsigned}, errUnableToParseNext},
+		{"Unordered payload", generated.SearchForTransactionsParams{AssetId: uint64Ptr(7), Next: &legacy}, errUnableToParseNext},
+		{"Other query", generated.SearchForTransactionsParams{AssetId: uint64Ptr(8), Next: &good}, errNextTokenMismatch},
+		{"Opposite order", generated.SearchForTransactionsParams{AssetId: uint64Ptr(7), Order: strPtr("desc"), Next: &good}, errNextTokenOrder},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var response generated.ErrorResponse
+			callHandler(t, func(c echo.Context) error { return si.SearchForTransactions(c, test.params) }, http.StatusBadRequest, &response)
+			assert.Equal(t, test.errorContains, response.Message)
+		})
+	}
+}
+
+func TestSearchForTransactionsDescendingPages(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	txnBytes := loadResourceFileOrPanic("test_resources/payment.txn")
+	rounds := []uint64{10, 9, 8, 7, 6, 5}
+	mockIndexer.On("Transactions", mock.Anything, mock.Anything).Return(func(ctx context.Context, tf idb.TransactionFilter) <-chan idb.TxnRow {
+		// rounds newest first, after the next token if there is one
+		assert.True(t, tf.Descending())
+		start := 0
+		if tf.NextToken != "" {
+			round, _, _, err := idb.DecodeTxnRowNext(tf.NextToken)
+			assert.NoError(t, err)
+			for start < len(rounds) && rounds[start] >= round {
+				start++
+			}
+		}
+		var rows []idb.TxnRow
+		for i := start; i < len(rounds) && i < start+int(tf.Limit); i++ {
+			rows = append(rows, idb.TxnRow{Round: rounds[i], RoundTime: time.Now(), TxnBytes: txnBytes})
+		}
+		return txnRows(rows...)
+	})
+	mockIndexer.On("GetMetastate", "retention").Return("", nil)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	// only the first page gives the order
+	params := generated.SearchForTransactionsParams{Limit: uint64Ptr(2), Order: strPtr("desc")}
+	var got []uint64
+	for page := 0; page < 3; page++ {
+		var response generated.TransactionsResponse
+		callHandler(t, func(c echo.Context) error { return si.SearchForTransactions(c, params) }, http.StatusOK, &response)
+		for _, txn := range response.Transactions {
+			got = append(got, *txn.ConfirmedRound)
+		}
+		params = generated.SearchForTransactionsParams{Limit: uint64Ptr(2), Next: response.NextToken}
+	}
+	assert.Equal(t, rounds, got)
+}
+
+func TestLookupAccountMultisigs(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var pk1, pk2 types.Address
+	pk1[0] = 1
+	pk2[0] = 2
+	outCh := multisigRows(idb.MultisigAccountRow{Address: make([]byte, 32), Version: 1, Threshold: 2, PublicKeys: [][]byte{pk1[:], pk2[:]}})
+	mockIndexer.On("MultisigAccounts", mock.Anything, pk1[:]).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	var response generated.MultisigAccountsResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupAccountMultisigs(c, pk1.String()) }, http.StatusOK, &response)
+	assert.Len(t, response.Multisigs, 1)
+	assert.Equal(t, uint64(2), response.Multisigs[0].Threshold)
+	assert.Equal(t, []string{pk1.String(), pk2.String()}, response.Multisigs[0].PublicKeys)
+}
+
+func TestLookupAccountAuthHistory(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var addr, auth types.Address
+	addr[0] = 1
+	auth[0] = 2
+	outCh := authHistoryRows(
+		idb.AuthHistoryRow{Round: 5, Txid: "rekey", NewAuth: auth[:]},
+		idb.AuthHistoryRow{Round: 7, Txid: "back", OldAuth: auth[:]},
+	)
+	mockIndexer.On("AuthHistory", mock.Anything, addr[:]).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	var response generated.AuthHistoryResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupAccountAuthHistory(c, addr.String()) }, http.StatusOK, &response)
+	assert.Len(t, response.AuthHistory, 2)
+	assert.Nil(t, response.AuthHistory[0].OldAuthAddr)
+	assert.Equal(t, auth.String(), *response.AuthHistory[0].NewAuthAddr)
+	assert.Equal(t, auth.String(), *response.AuthHistory[1].OldAuthAddr)
+	assert.Nil(t, response.AuthHistory[1].NewAuthAddr)
+}
+
+func TestLookupStake(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	totals := idb.StakeTotals{Round: 10, Online: 100, Offline: 20, NotParticipating: 3}
+	mockIndexer.On("StakeTotals", mock.Anything).Return(totals, nil)
+
+	var response generated.StakeResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupStake(c) }, http.StatusOK, &response)
+	assert.Equal(t, generated.StakeResponse{CurrentRound: 10, OnlineMoney: 100, OfflineMoney: 20, NotParticipatingMoney: 3}, response)
+}
+
+func TestLookupAccountParticipationHistory(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var addr types.Address
+	addr[0] = 1
+	key := make([]byte, 32)
+	key[0] = 3
+	outCh := participationHistoryRows(
+		idb.ParticipationHistoryRow{Round: 5, Txid: "online", Status: 1, VoteKey: key, SelectionKey: key, VoteFirst: 5, VoteLast: 1000, VoteKeyDilution: 10},
+		idb.ParticipationHistoryRow{Round: 9, Txid: "offline", Status: 0},
+	)
+	mockIndexer.On("ParticipationHistory", mock.Anything, addr[:]).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	var response generated.ParticipationHistoryResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupAccountParticipationHistory(c, addr.String()) }, http.StatusOK, &response)
+	assert.Len(t, response.ParticipationHistory, 2)
+	assert.Equal(t, "Online", response.ParticipationHistory[0].Status)
+	assert.Equal(t, uint64(1000), response.ParticipationHistory[0].Participation.VoteLastValid)
+	assert.Equal(t, "Offline", response.ParticipationHistory[1].Status)
+	assert.Nil(t, response.ParticipationHistory[1].Participation)
+}
+
+func TestLookupAccountRewards(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var addr types.Address
+	addr[0] = 1
+	base := uint64(5)
+	account := generated.Account{
+		Address:                     addr.String(),
+		Round:                       10,
+		AmountWithoutPendingRewards: 3500000,
+		RewardBase:                  &base,
+		PendingRewards:              6,
+		Amount:                      3500006,
+		Rewards:                     106,
+	}
+	outCh := accountRows(idb.AccountRow{Account: account})
+	mockIndexer.On("GetAccounts", mock.Anything, mock.Anything).Return(outCh)
+	var block types.Block
+	block.RewardsLevel = 7
+	block.CurrentProtocol = "test"
+	mockIndexer.On("GetBlock", uint64(10)).Return(block, nil)
+	mockIndexer.On("GetProto", "test").Return(types.ConsensusParams{RewardUnit: 1000000}, nil)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	var response generated.AccountRewardsResponse
+	callHandler(t, func(c echo.Context) error {
+		return si.LookupAccountRewards(c, addr.String(), generated.LookupAccountRewardsParams{})
+	}, http.StatusOK, &response)
+	assert.Equal(t, uint64(3), response.Rewards.RewardUnits)
+	assert.Equal(t, uint64(5), response.Rewards.RewardBase)
+	assert.Equal(t, uint64(7), response.Rewards.RewardsLevel)
+	assert.Equal(t, uint64(100), response.Rewards.CreditedRewards)
+	assert.Equal(t, uint64(6), response.Rewards.PendingRewards)
+}
+
+func TestLookupAssetByIDDestroyed(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	outCh := assetRows(idb.AssetRow{
+		AssetId:        7,
+		Creator:        make([]byte, 32),
+		Params:         types.AssetParams{Total: 100, UnitName: "x"},
+		CreatedRound:   uint64Ptr(3),
+		DestroyedRound: uint64Ptr(9),
+		Deleted:        true,
+	})
+	mockIndexer.On("Assets", mock.Anything, mock.MatchedBy(func(aq idb.AssetsQuery) bool {
+		return aq.AssetId == 7 && aq.IncludeDeleted
+	})).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	var response generated.AssetResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupAssetByID(c, 7) }, http.StatusOK, &response)
+	assert.Equal(t, uint64(100), response.Asset.Params.Total)
+	assert.Equal(t, uint64(9), *response.Asset.DestroyedAtRound)
+	assert.True(t, *response.Asset.Deleted)
+}
+
+func TestLookupAssetConfigHistory(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var creator, manager types.Address
+	creator[0] = 1
+	manager[0] = 2
+	outCh := assetConfigRows(
+		idb.AssetConfigHistoryRow{Round: 3, Txid: "CREATE", Sender: creator[:], Creator: creator[:], Params: &types.AssetParams{Total: 100, Manager: manager}},
+		idb.AssetConfigHistoryRow{Round: 9, Txid: "DESTROY", Sender: manager[:], Creator: creator[:]},
+	)
+	mockIndexer.On("AssetConfigHistory", mock.Anything, uint64(7)).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	var response generated.AssetConfigHistoryResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupAssetConfigHistory(c, 7) }, http.StatusOK, &response)
+	assert.Len(t, response.ConfigHistory, 2)
+	assert.False(t, response.ConfigHistory[0].Destroyed)
+	assert.Equal(t, creator.String(), response.ConfigHistory[0].Params.Creator)
+	assert.Equal(t, manager.String(), *response.ConfigHistory[0].Params.Manager)
+	assert.True(t, response.ConfigHistory[1].Destroyed)
+	assert.Nil(t, response.ConfigHistory[1].Params)
+	assert.Equal(t, manager.String(), response.ConfigHistory[1].Sender)
+}
+
+func TestSearchForAssetsByRole(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var manager types.Address
+	manager[0] = 2
+	outCh := assetRows(idb.AssetRow{AssetId: 7, Creator: make([]byte, 32), Params: types.AssetParams{Total: 100, Manager: manager}})
+	mockIndexer.On("Assets", mock.Anything, mock.MatchedBy(func(aq idb.AssetsQuery) bool {
+		return bytes.Equal(aq.Manager, manager[:]) && aq.Reserve == nil && aq.Freeze == nil && aq.Clawback == nil
+	})).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	var response generated.AssetsResponse
+	callHandler(t, func(c echo.Context) error {
+		return si.SearchForAssets(c, generated.SearchForAssetsParams{Manager: strPtr(manager.String())})
+	}, http.StatusOK, &response)
+	assert.Len(t, response.Assets, 1)
+	assert.Equal(t, manager.String(), *response.Assets[0].Params.Manager)
+
+	callHandler(t, func(c echo.Context) error {
+		return si.SearchForAssets(c, generated.SearchForAssetsParams{Clawback: strPtr("not an address")})
+	}, http.StatusBadRequest, nil)
+}
+
+func TestSearchForAssetsRelevance(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	outCh := assetRows(idb.AssetRow{AssetId: 7, Creator: make([]byte, 32), Params: types.AssetParams{Total: 100, AssetName: "Gold"}})
+	mockIndexer.On("Assets", mock.Anything, mock.MatchedBy(func(aq idb.AssetsQuery) bool {
+		return aq.Query == "go" && aq.Match == idb.AssetMatchPrefix && aq.OrderByRelevance
+	})).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	params := generated.SearchForAssetsParams{
+		Limit: uint64Ptr(1),
+		Query: strPtr("go"),
+		Match: strPtr("prefix"),
+		Order: strPtr("relevance"),
+	}
+	var response generated.AssetsResponse
+	callHandler(t, func(c echo.Context) error { return si.SearchForAssets(c, params) }, http.StatusOK, &response)
+	assert.Len(t, response.Assets, 1)
+	assert.Nil(t, response.NextToken)
+
+	body := callHandler(t, func(c echo.Context) error {
+		return si.SearchForAssets(c, generated.SearchForAssetsParams{Order: strPtr("relevance")})
+	}, http.StatusBadRequest, nil)
+	assert.Contains(t, body, errRelevanceWithoutText)
+}
+
+func TestLookupAssetSupply(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	var creator, holder types.Address
+	creator[0] = 1
+	holder[0] = 2
+	params := types.AssetParams{Total: 100, Reserve: creator}
+	mockIndexer.On("Assets", mock.Anything, mock.MatchedBy(func(aq idb.AssetsQuery) bool {
+		return aq.AssetId == 7 && aq.IncludeDeleted
+	})).Return(func(ctx context.Context, aq idb.AssetsQuery) <-chan idb.AssetRow {
+		return assetRows(idb.AssetRow{AssetId: 7, Creator: creator[:], Params: params, CreatedRound: uint64Ptr(2)})
+	})
+	supply := idb.AssetSupply{Round: 10, Total: 100, Reserve: 60, Creator: 60, Holders: 2, ZeroBalance: 1}
+	mockIndexer.On("AssetSupply", mock.Anything, uint64(7)).Return(supply, nil)
+	mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+
+	// current holdings and the transfer to holder to undo
+	configsCh := assetConfigRows(idb.AssetConfigHistoryRow{Round: 2, Sender: creator[:], Creator: creator[:], Params: &params})
+	mockIndexer.On("AssetConfigHistory", mock.Anything, uint64(7)).Return(configsCh)
+	balancesCh := assetBalanceRows(
+		idb.AssetBalanceRow{Address: creator[:], AssetId: 7, Amount: 60, CreatedRound: uint64Ptr(2)},
+		idb.AssetBalanceRow{Address: holder[:], AssetId: 7, Amount: 40, CreatedRound: uint64Ptr(5)},
+		idb.AssetBalanceRow{Address: make([]byte, 32), AssetId: 7, CreatedRound: uint64Ptr(8)},
+	)
+	mockIndexer.On("AssetBalances", mock.Anything, mock.Anything).Return(balancesCh)
+	var stxn types.SignedTxnWithAD
+	stxn.Txn.Type = "axfer"
+	stxn.Txn.Sender = creator
+	stxn.Txn.XferAsset = 7
+	stxn.Txn.AssetReceiver = holder
+	stxn.Txn.AssetAmount = 40
+	txnsCh := txnRows(idb.TxnRow{Round: 6, AssetId: 7, TxnBytes: msgpack.Encode(stxn)})
+	mockIndexer.On("Transactions", mock.Anything, mock.MatchedBy(func(tf idb.TransactionFilter) bool {
+		return tf.AssetId == 7 && tf.MinRound == 5 && tf.MaxRound == 10
+	})).Return(txnsCh)
+	mockIndexer.On("GetMetastate", mock.Anything).Return("", nil)
+
+	var response generated.AssetSupplyResponse
+	callHandler(t, func(c echo.Context) error { return si.LookupAssetSupply(c, 7, generated.LookupAssetSupplyParams{}) }, http.StatusOK, &response)
+	assert.Equal(t, generated.AssetSupply{AssetId: 7, Round: 10, Total: 100, ReserveAmount: 60, CreatorAmount: 60, Holders: 2, ZeroBalanceHolders: 1}, response.Supply)
+
+	callHandler(t, func(c echo.Context) error {
+		return si.LookupAssetSupply(c, 7, generated.LookupAssetSupplyParams{Round: uint64Ptr(4)})
+	}, http.StatusOK, &response)
+	assert.Equal(t, generated.AssetSupply{AssetId: 7, Round: 4, Total: 100, ReserveAmount: 100, CreatorAmount: 100, Holders: 1}, response.Supply)
+}
+
+func TestLookupAssetSupplyRewind(t *testing.T) {
+	var creator, holder types.Address
+	creator[0] = 1
+	holder[0] = 2
+	params := types.AssetParams{Total: 100}
+	// mockSupply has the asset created by creator with txns to undo
+	// after round 4, and holder currently frozen with all of it
+	mockSupply := func(supply idb.AssetSupply, txns ...types.SignedTxnWithAD) (*mocks.IndexerDb, *ServerImplementation) {
+		mockIndexer, si := newMockServer()
+		assetsCh := assetRows(idb.AssetRow{AssetId: 7, Creator: creator[:], Params: params})
+		mockIndexer.On("Assets", mock.Anything, mock.Anything).Return(assetsCh)
+		mockIndexer.On("AssetSupply", mock.Anything, uint64(7)).Return(supply, nil)
+		mockIndexer.On("GetMaxRound").Return(uint64(10), nil)
+		mockIndexer.On("GetMetastate", mock.Anything).Return("", nil)
+		configsCh := assetConfigRows()
+		mockIndexer.On("AssetConfigHistory", mock.Anything, uint64(7)).Return(configsCh)
+		balancesCh := assetBalanceRows(idb.AssetBalanceRow{Address: holder[:], AssetId: 7, Amount: 100, Frozen: true})
+		mockIndexer.On("AssetBalances", mock.Anything, mock.Anything).Return(balancesCh)
+		rows := make([]idb.TxnRow, len(txns))
+		for i, stxn := range txns {
+			rows[i] = idb.TxnRow{Round: 6, AssetId: 7, TxnBytes: msgpack.Encode(stxn)}
+		}
+		rowsCh := txnRows(rows...)
+		mockIndexer.On("Transactions", mock.Anything, mock.MatchedBy(func(tf idb.TransactionFilter) bool {
+			return tf.MinRound == 5
+		})).Return(rowsCh)
+		return mockIndexer, si
+	}
+	atRound4 := func(c echo.Context, si *ServerImplementation) error {
+		return si.LookupAssetSupply(c, 7, generated.LookupAssetSupplyParams{Round: uint64Ptr(4)})
+	}
+
+	// too many holdings to rewind
+	_, si := mockSupply(idb.AssetSupply{Round: 10, Total: 100, Holders: maxSupplyRewindHoldings + 1})
+	body := callHandler(t, func(c echo.Context) error { return atRound4(c, si) }, http.StatusBadRequest, nil)
+	assert.Contains(t, body, errSupplyRewindTooLarge)
+
+	// created after round without a recorded created round
+	var create types.SignedTxnWithAD
+	create.Txn.Type = "acfg"
+	create.Txn.Sender = creator
+	create.Txn.AssetParams = params
+	_, si = mockSupply(idb.AssetSupply{Round: 10, Total: 100, Holders: 1}, create)
+	callHandler(t, func(c echo.Context) error { return atRound4(c, si) }, http.StatusNotFound, nil)
+
+	// undoing more than the holding has
+	var xfer types.SignedTxnWithAD
+	xfer.Txn.Type = "axfer"
+	xfer.Txn.Sender = creator
+	xfer.Txn.XferAsset = 7
+	xfer.Txn.AssetReceiver = holder
+	xfer.Txn.AssetAmount = 200
+	_, si = mockSupply(idb.AssetSupply{Round: 10, Total: 100, Holders: 1}, xfer)
+	body = callHandler(t, func(c echo.Context) error { return atRound4(c, si) }, http.StatusInternalServerError, nil)
+	assert.Contains(t, body, errRewindingAsset)
+
+	// an undone afrz that froze an already frozen holding
+	var freeze types.SignedTxnWithAD
+	freeze.Txn.Type = "afrz"
+	freeze.Txn.FreezeAsset = 7
+	freeze.Txn.FreezeAccount = holder
+	freeze.Txn.AssetFrozen = true
+	mockIndexer, si := mockSupply(idb.AssetSupply{Round: 10, Total: 100, Frozen: 100, Holders: 1}, freeze)
+	earlierCh := txnRows(idb.TxnRow{Round: 3, AssetId: 7, TxnBytes: msgpack.Encode(freeze)})
+	mockIndexer.On("Transactions", mock.Anything, mock.MatchedBy(func(tf idb.TransactionFilter) bool {
+		return tf.AddressRole == idb.AddressRoleFreeze && tf.MaxRound == 4
+	})).Return(earlierCh)
+	var response generated.AssetSupplyResponse
+	callHandler(t, func(c echo.Context) error { return atRound4(c, si) }, http.StatusOK, &response)
+	assert.Equal(t, generated.AssetSupply{AssetId: 7, Round: 4, Total: 100, FrozenAmount: 100, Holders: 1}, response.Supply)
+}
+
+func TestLookupStats(t *testing.T) {
+	mockIndexer, si := newMockServer()
+
+	start := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	outCh := statsRows(idb.StatsRow{FirstRound: 100, LastRound: 180, Start: start, Txns: 7, TxnCounts: map[string]uint64{"pay": 5, "axfer": 2}, AlgoVolume: 1000, Fees: 7000, NewAccounts: 1, ActiveAccounts: 3})
+	query := idb.StatsQuery{Interval: idb.StatsIntervalHour, MinRound: 100, Limit: defaultStatsLimit}
+	mockIndexer.On("Stats", mock.Anything, query).Return(outCh)
+	mockIndexer.On("GetMaxRound").Return(uint64(200), nil)
+
+	var response generated.StatsResponse
+	callHandler(t, func(c echo.Context) error {
+		return si.LookupStats(c, generated.LookupStatsParams{Interval: strPtr("hour"), MinRound: uint64Ptr(100)})
+	}, http.StatusOK, &response)
+	assert.Len(t, response.Stats, 1)
+	assert.Equal(t, uint64(start.Unix()), response.Stats[0].StartTime)
+	assert.Equal(t, uint64(5), response.Stats[0].PayTxns)
+	assert.Equal(t, uint64(2), response.Stats[0].AxferTxns)
+	assert.Equal(t, uint64(0), response.Stats[0].KeyregTxns)
+	assert.Equal(t, uint64(3), response.Stats[0].ActiveAccounts)
+
+	callHandler(t, func(c echo.Context) error {
+		return si.LookupStats(c, generated.LookupStatsParams{Interval: strPtr("week")})
+	}, http.StatusBadRequest, nil)
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/indexer/idb"
+)
+
+// Next tokens are opaque to clients. They are unpadded base64url of
+//   version(1) | kind(1) | filter hash(8) | payload | HMAC-SHA256(16)
+// The filter hash ties a token to the query it came from, so a token
+// can't be used to resume a different search. The HMAC is only present
+// when the server is configured with a NextTokenKey.
+
+const nextTokenVersion = 1
+
+type nextTokenKind byte
+
+const (
+	nextTokenTransactions nextTokenKind = iota + 1
+	nextTokenAccounts
+	nextTokenAssets
+	nextTokenBalances
+)
+
+const nextTokenHeaderLen = 1 + 1 + 8
+const nextTokenMacLen = 16
+
+var errNextTokenMalformed = errors.New(errUnableToParseNext)
+var errNextTokenWrongQuery = errors.New(errNextTokenMismatch)
+
+// nextTokenFilterHash hashes the query a next token belongs to. Paging
+// fields (limit and the position to resume from) should be cleared
+// first.
+func nextTokenFilterHash(filter interface{}) ([]byte, error) {
+	js, err := json.Marshal(filter)
+	if err != nil {
+		return nil, fmt.Errorf("next token filter hash, %v", err)
+	}
+	sum := sha256.Sum256(js)
+	return sum[:8], nil
+}
+
+func (si *ServerImplementation) nextTokenMac(b []byte) []byte {
+	mac := hmac.New(sha256.New, si.NextTokenKey)
+	mac.Write(b)
+	return mac.Sum(nil)[:nextTokenMacLen]
+}
+
+// encodeNext builds a next token for the kind of query and its filter.
+func (si *ServerImplementation) encodeNext(kind nextTokenKind, filter interface{}, payload []byte) (string, error) {
+	fh, err := nextTokenFilterHash(filter)
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, 0, nextTokenHeaderLen+len(payload)+nextTokenMacLen)
+	b = append(b, nextTokenVersion, byte(kind))
+	b = append(b, fh...)
+	b = append(b, payload...)
+	if len(si.NextTokenKey) > 0 {
+		b = append(b, si.nextTokenMac(b)...)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeNext checks a token from encodeNext against the kind of query
+// and its filter and returns the payload.
+func (si *ServerImplementation) decodeNext(token string, kind nextTokenKind, filter interface{}) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errNextTokenMalformed
+	}
+	if len(si.NextTokenKey) > 0 {
+		if len(b) < nextTokenHeaderLen+nextTokenMacLen {
+			return nil, errNextTokenMalformed
+		}
+		mac := b[len(b)-nextTokenMacLen:]
+		b = b[:len(b)-nextTokenMacLen]
+		if !hmac.Equal(mac, si.nextTokenMac(b)) {
+			return nil, errNextTokenMalformed
+		}
+	}
+	if len(b) < nextTokenHeaderLen || b[0] != nextTokenVersion {
+		return nil, errNextTokenMalformed
+	}
+	if nextTokenKind(b[1]) != kind {
+		return nil, errNextTokenWrongQuery
+	}
+	fh, err := nextTokenFilterHash(filter)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(fh, b[2:nextTokenHeaderLen]) {
+		return nil, errNextTokenWrongQuery
+	}
+	return b[nextTokenHeaderLen:], nil
+}
+
+// txnNextFilter is the part of a TransactionFilter a next token is bound
+// to. Order is carried in the idb token payload instead.
+func txnNextFilter(filter idb.TransactionFilter) idb.TransactionFilter {
+	filter.NextToken = ""
+	filter.Limit = 0
+	filter.Order = idb.TxnOrderDefault
+	return filter
+}
+
+// decodeTxnNext replaces filter.NextToken with the idb token it wraps,
+// and takes filter.Order from it when the request doesn't give one.
+func (si *ServerImplementation) decodeTxnNext(filter *idb.TransactionFilter) error {
+	payload, err := si.decodeNext(filter.NextToken, nextTokenTransactions, txnNextFilter(*filter))
+	if err != nil {
+		return err
+	}
+	_, _, order, err := idb.DecodeTxnRowNext(string(payload))
+	if err != nil {
+		return errNextTokenMalformed
+	}
+	if filter.Order != idb.TxnOrderDefault && filter.Order != order {
+		return errors.New(errNextTokenOrder)
+	}
+	if filter.Order == idb.TxnOrderDefault {
+		// the order was only given on the first request, keep paging that way
+		filter.Order = order
+	}
+	filter.NextToken = string(payload)
+	return nil
+}
+
+// balanceNextPayload is the position in results ordered by amount then
+// address, or by address alone when ordered is false.
+func balanceNextPayload(ordered bool, amount uint64, addr []byte) []byte {
+	if !ordered {
+		return addr
+	}
+	b := make([]byte, 8+len(addr))
+	binary.LittleEndian.PutUint64(b[:8], amount)
+	copy(b[8:], addr)
+	return b
+}
+
+// parseBalanceNextPayload unpacks balanceNextPayload.
+func parseBalanceNextPayload(ordered bool, b []byte) (amount uint64, addr []byte, err error) {
+	if !ordered {
+		if len(b) != 32 {
+			return 0, nil, errNextTokenMalformed
+		}
+		return 0, b, nil
+	}
+	if len(b) != 8+32 {
+		return 0, nil, errNextTokenMalformed
+	}
+	return binary.LittleEndian.Uint64(b[:8]), b[8:], nil
+}
+
+// accountsNextFilter is the part of an accounts search a next token is bound to.
+type accountsNextFilter struct {
+	Options idb.AccountQueryOptions
+	Round   *uint64
+}
+
+func makeAccountsNextFilter(options idb.AccountQueryOptions, round *uint64) accountsNextFilter {
+	options.GreaterThanAddress = nil
+	options.BalanceBefore = 0
+	options.AddressBefore = nil
+	options.Limit = 0
+	return accountsNextFilter{Options: options, Round: round}
+}
+
+// assetsNextFilter is the part of an asset search a next token is bound to.
+func assetsNextFilter(query idb.AssetsQuery) idb.AssetsQuery {
+	query.AssetIdGreaterThan = 0
+	query.Limit = 0
+	return query
+}
+
+// balancesNextFilter is the part of an asset balances query a next token is bound to.
+func balancesNextFilter(query idb.AssetBalanceQuery) idb.AssetBalanceQuery {
+	query.PrevAddress = nil
+	query.PrevAmount = 0
+	query.Limit = 0
+	return query
+}
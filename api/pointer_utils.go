@@ -23,6 +23,13 @@ func uintOrDefaultValue(x *uint64, value uint64) uint64 {
 	return value
 }
 
+func boolOrDefault(b *bool) bool {
+	if b != nil {
+		return *b
+	}
+	return false
+}
+
 func strOrDefault(str *string) string {
 	if str != nil {
 		return *str
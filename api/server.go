@@ -20,7 +20,8 @@ import (
 var indexerDb idb.IndexerDb
 
 // Serve starts an http server for the indexer API. This call blocks.
-func Serve(ctx context.Context, serveAddr string, db idb.IndexerDb, log *log.Logger, tokens []string, developerMode bool) {
+// nextTokenKey may be empty, otherwise it signs next tokens.
+func Serve(ctx context.Context, serveAddr string, db idb.IndexerDb, log *log.Logger, tokens []string, developerMode bool, nextTokenKey []byte) {
 	indexerDb = db
 
 	e := echo.New()
@@ -37,6 +38,7 @@ func Serve(ctx context.Context, serveAddr string, db idb.IndexerDb, log *log.Log
 
 	api := ServerImplementation{
 		EnableAddressSearchRoundRewind: developerMode,
+		NextTokenKey:                   nextTokenKey,
 		db:                             db,
 	}
 
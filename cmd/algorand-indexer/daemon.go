@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,6 +31,9 @@ var (
 	noAlgod          bool
 	developerMode    bool
 	tokenString      string
+	nextTokenKey     string
+	retentionRounds  int
+	retentionDays    int
 
 	configFilePath string
 
@@ -88,6 +92,11 @@ var daemonCmd = &cobra.Command{
 			maybeFail(err, "import proto, %v\n", err)
 		}
 		if bot != nil {
+			if snapshotJsonPath != "" {
+				// Load the snapshot (if not already done) before asking where to resume fetching, so that we pick up after the snapshot round.
+				updateAccounting(db)
+			}
+			pruneTransactions(db)
 			maxRound, err := db.GetMaxRound()
 			if err == nil {
 				bot.SetNextRound(maxRound + 1)
@@ -112,7 +121,7 @@ var daemonCmd = &cobra.Command{
 
 		// TODO: trap SIGTERM and call cf() to exit gracefully
 		fmt.Printf("serving on %s\n", daemonServerAddr)
-		api.Serve(ctx, daemonServerAddr, db, logger, tokenArray, developerMode)
+		api.Serve(ctx, daemonServerAddr, db, logger, tokenArray, developerMode, []byte(nextTokenKey))
 	},
 }
 
@@ -174,6 +183,30 @@ func configBoolVarP(flags *pflag.FlagSet, boolPtr *bool, name, short string, val
 	configVars = append(configVars, configVar{name, short, usage, &configBoolVar{value, boolPtr}})
 }
 
+type configIntVar struct {
+	value int
+	ptr   *int
+}
+
+func (sv configIntVar) Set(x string) {
+	// only set if it still has the original value
+	if *sv.ptr != sv.value {
+		return
+	}
+	v, err := strconv.Atoi(x)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bad int value %#v, %v\n", x, err)
+		return
+	}
+	*sv.ptr = v
+}
+
+func configIntVarP(flags *pflag.FlagSet, intPtr *int, name, short string, value int, usage string) {
+	*intPtr = value
+	flags.IntVarP(intPtr, name, short, value, usage)
+	configVars = append(configVars, configVar{name, short, usage, &configIntVar{value, intPtr}})
+}
+
 // TODO: maybe someday replace file parsing with YAML library, but for now we don't need nested structure and a smaller dependency tree makes me happy
 func configFromStream(in io.Reader) (err error) {
 	lineno := 0
@@ -223,16 +256,21 @@ func init() {
 	configStringVarP(daemonCmd.Flags(), &algodAddr, "algod-net", "", "", "host:port of algod")
 	configStringVarP(daemonCmd.Flags(), &algodToken, "algod-token", "", "", "api access token for algod")
 	configStringVarP(daemonCmd.Flags(), &genesisJsonPath, "genesis", "g", "", "path to genesis.json (defaults to genesis.json in algod data dir if that was set)")
+	configStringVarP(daemonCmd.Flags(), &snapshotJsonPath, "snapshot", "", "", "path to ledger snapshot json, used instead of genesis to start indexing from the snapshot round")
 	configStringVarP(daemonCmd.Flags(), &daemonServerAddr, "server", "S", ":8980", "host:port to serve API on (default :8980)")
 	configBoolVarP(daemonCmd.Flags(), &noAlgod, "no-algod", "", false, "disable connecting to algod for block following")
 	configStringVarP(daemonCmd.Flags(), &tokenString, "token", "t", "", "an optional auth token, when set REST calls must use this token in a bearer format, or in a 'X-Indexer-API-Token' header")
+	configStringVarP(daemonCmd.Flags(), &nextTokenKey, "next-token-key", "", "", "an optional secret used to sign paging next tokens, share it between servers behind one load balancer")
 	configBoolVarP(daemonCmd.Flags(), &developerMode, "dev-mode", "", false, "allow performance intensive operations like searching for accounts at a particular round")
+	configIntVarP(daemonCmd.Flags(), &retentionRounds, "retention-rounds", "", 0, "delete transactions older than this many rounds (default 0 keeps all)")
+	configIntVarP(daemonCmd.Flags(), &retentionDays, "retention-days", "", 0, "delete transactions older than this many days (default 0 keeps all)")
 
 	daemonCmd.Flags().StringVarP(&configFilePath, "config", "c", "", "path to 'key: value' config file, keys are same as command line options")
 
 	// Make config entries for global flags
 	configVars = append(configVars, configVar{"postgres", "P", "", &configStringVar{"", &postgresAddr}})
 	configVars = append(configVars, configVar{"pidfile", "", "", &configStringVar{"", &pidFilePath}})
+	configVars = append(configVars, configVar{"txn-storage", "", "", &configStringVar{"", &txnStorage}})
 }
 
 type blockImporterHandler struct {
@@ -269,4 +307,31 @@ func (bih *blockImporterHandler) HandleBlock(block *types.EncodedBlockCert) {
 	}
 	fmt.Printf("round r=%d (%d txn) imported in %s\n", block.Block.Round, len(block.Block.Payset), dt.String())
 	bih.round = uint64(block.Block.Round)
+	if bih.round%pruneIntervalRounds == 0 {
+		pruneTransactions(bih.db)
+	}
+}
+
+// How often the daemon applies the retention policy.
+const pruneIntervalRounds = 100
+
+func retentionPolicy() idb.RetentionPolicy {
+	return idb.RetentionPolicy{
+		Rounds: uint64(retentionRounds),
+		Age:    time.Duration(retentionDays) * 24 * time.Hour,
+	}
+}
+
+func pruneTransactions(db idb.IndexerDb) {
+	policy := retentionPolicy()
+	if policy.Rounds == 0 && policy.Age == 0 {
+		return
+	}
+	start := time.Now()
+	earliest, err := db.PruneTransactions(policy)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "pruning transactions, %v\n", err)
+		return
+	}
+	fmt.Printf("transactions before round %d pruned in %s\n", earliest, time.Now().Sub(start).String())
 }
@@ -129,6 +129,20 @@ func loadGenesis(db idb.IndexerDb, in io.Reader) (err error) {
 	return db.LoadGenesis(genesis)
 }
 
+func loadSnapshot(db idb.IndexerDb, in io.Reader) (round uint64, err error) {
+	var snapshot types.LedgerSnapshot
+	sbytes, err := ioutil.ReadAll(in)
+	if err != nil {
+		return 0, fmt.Errorf("error reading snapshot, %v", err)
+	}
+	err = json.Decode(sbytes, &snapshot)
+	if err != nil {
+		return 0, fmt.Errorf("error decoding snapshot, %v", err)
+	}
+
+	return uint64(snapshot.Block.Round), db.LoadSnapshot(snapshot)
+}
+
 func updateAccounting(db idb.IndexerDb) (rounds, txnCount int) {
 	rounds = 0
 	txnCount = 0
@@ -136,7 +150,17 @@ func updateAccounting(db idb.IndexerDb) (rounds, txnCount int) {
 	maybeFail(err, "getting import state, %v\n", err)
 	var state idb.ImportState
 	if stateJsonStr == "" {
-		if genesisJsonPath != "" {
+		if snapshotJsonPath != "" {
+			fmt.Printf("loading snapshot %s\n", snapshotJsonPath)
+			// if we're given no previous state and we're given a snapshot, start accounting from the round it was taken at
+			sf, err := os.Open(snapshotJsonPath)
+			maybeFail(err, "%s: %v\n", snapshotJsonPath, err)
+			round, err := loadSnapshot(db, sf)
+			sf.Close()
+			maybeFail(err, "%s: could not load snapshot json, %v\n", snapshotJsonPath, err)
+			state.AccountRound = int64(round)
+			fmt.Printf("will start from round >%d\n", state.AccountRound)
+		} else if genesisJsonPath != "" {
 			fmt.Printf("loading genesis %s\n", genesisJsonPath)
 			// if we're given no previous state and we're given a genesis file, import it as initial account state
 			gf, err := os.Open(genesisJsonPath)
@@ -146,7 +170,7 @@ func updateAccounting(db idb.IndexerDb) (rounds, txnCount int) {
 			rounds++
 			state.AccountRound = -1
 		} else {
-			fmt.Fprintf(os.Stderr, "no import state recorded; need --genesis genesis.json file to get started\n")
+			fmt.Fprintf(os.Stderr, "no import state recorded; need --genesis genesis.json or --snapshot snapshot.json file to get started\n")
 			os.Exit(1)
 			return
 		}
@@ -195,10 +219,11 @@ func updateAccounting(db idb.IndexerDb) (rounds, txnCount int) {
 }
 
 var (
-	genesisJsonPath string
-	protoJsonPath   string
-	numRoundsLimit  int
-	blockFileLimit  int
+	genesisJsonPath  string
+	snapshotJsonPath string
+	protoJsonPath    string
+	numRoundsLimit   int
+	blockFileLimit   int
 )
 
 type blockTarPaths []string
@@ -302,6 +327,7 @@ var importCmd = &cobra.Command{
 
 func init() {
 	importCmd.Flags().StringVarP(&genesisJsonPath, "genesis", "g", "", "path to genesis.json")
+	importCmd.Flags().StringVarP(&snapshotJsonPath, "snapshot", "", "", "path to ledger snapshot json, used instead of genesis to start accounting from the snapshot round")
 	importCmd.Flags().IntVarP(&numRoundsLimit, "num-rounds-limit", "", 0, "number of rounds to process")
 	importCmd.Flags().IntVarP(&blockFileLimit, "block-file-limit", "", 0, "number of block files to process (for debugging)")
 }
@@ -6,6 +6,7 @@ import (
 	"os"
 	"runtime/pprof"
 
+	"github.com/algorand/go-algorand-sdk/encoding/json"
 	"github.com/spf13/cobra"
 	//"github.com/spf13/cobra/doc" // TODO: enable cobra doc generation
 
@@ -57,6 +58,7 @@ var (
 	dummyIndexerDb bool
 	cpuProfile     string
 	pidFilePath    string
+	txnStorage     string
 	db             idb.IndexerDb
 	profFile       io.WriteCloser
 )
@@ -67,6 +69,14 @@ func globalIndexerDb() idb.IndexerDb {
 			var err error
 			db, err = idb.IndexerDbByName("postgres", postgresAddr)
 			maybeFail(err, "could not init db, %v\n", err)
+			if txnStorage != "" {
+				if txnStorage != idb.TxnStorageFull && txnStorage != idb.TxnStorageMsgpack {
+					fmt.Fprintf(os.Stderr, "unknown txn-storage %#v, should be %s or %s\n", txnStorage, idb.TxnStorageFull, idb.TxnStorageMsgpack)
+					os.Exit(1)
+				}
+				err = db.SetMetastate("txn_storage", string(json.Encode(txnStorage)))
+				maybeFail(err, "could not set txn storage mode, %v\n", err)
+			}
 		} else if dummyIndexerDb {
 			db = idb.DummyIndexerDb()
 		} else {
@@ -85,6 +95,7 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&dummyIndexerDb, "dummydb", "n", false, "use dummy indexer db")
 	rootCmd.PersistentFlags().StringVarP(&cpuProfile, "cpuprofile", "", "", "file to record cpu profile to")
 	rootCmd.PersistentFlags().StringVarP(&pidFilePath, "pidfile", "", "", "file to write daemon's process id to")
+	rootCmd.PersistentFlags().StringVarP(&txnStorage, "txn-storage", "", "", "how to store new transactions: \"full\" keeps msgpack and JSON, \"msgpack\" keeps only msgpack to save space (default is the database's current setting, initially full)")
 }
 
 func main() {
@@ -89,7 +89,7 @@ func testTxnPaging(db idb.IndexerDb, q idb.TransactionFilter) {
 				any = false // get out
 				break
 			}
-			next = txnrow.Next()
+			next = txnrow.Next(q.Descending())
 		}
 		q.NextToken = next
 		page++
@@ -8,6 +8,9 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/algorand/go-algorand-sdk/crypto"
+	atypes "github.com/algorand/go-algorand-sdk/types"
+
 	models "github.com/algorand/indexer/api/generated/v2"
 
 	"github.com/algorand/indexer/types"
@@ -44,6 +47,10 @@ func (db *dummyIndexerDb) LoadGenesis(genesis types.Genesis) (err error) {
 	return nil
 }
 
+func (db *dummyIndexerDb) LoadSnapshot(snapshot types.LedgerSnapshot) (err error) {
+	return nil
+}
+
 func (db *dummyIndexerDb) SetProto(version string, proto types.ConsensusParams) (err error) {
 	return nil
 }
@@ -73,6 +80,10 @@ func (db *dummyIndexerDb) CommitRoundAccounting(updates RoundUpdates, round, rew
 	return nil
 }
 
+func (db *dummyIndexerDb) PruneTransactions(policy RetentionPolicy) (earliestRound uint64, err error) {
+	return 0, nil
+}
+
 func (db *dummyIndexerDb) GetBlock(round uint64) (block types.Block, err error) {
 	err = nil
 	return
@@ -93,6 +104,38 @@ func (db *dummyIndexerDb) AssetBalances(ctx context.Context, abq AssetBalanceQue
 	return nil
 }
 
+func (db *dummyIndexerDb) MultisigAccounts(ctx context.Context, pubkey []byte) <-chan MultisigAccountRow {
+	return nil
+}
+
+func (db *dummyIndexerDb) AuthHistory(ctx context.Context, addr []byte) <-chan AuthHistoryRow {
+	return nil
+}
+
+func (db *dummyIndexerDb) ParticipationHistory(ctx context.Context, addr []byte) <-chan ParticipationHistoryRow {
+	return nil
+}
+
+func (db *dummyIndexerDb) AssetConfigHistory(ctx context.Context, assetId uint64) <-chan AssetConfigHistoryRow {
+	return nil
+}
+
+func (db *dummyIndexerDb) AssetSupply(ctx context.Context, assetId uint64) (supply AssetSupply, err error) {
+	return
+}
+
+func (db *dummyIndexerDb) Stats(ctx context.Context, sq StatsQuery) <-chan StatsRow {
+	return nil
+}
+
+func (db *dummyIndexerDb) AssetStats(ctx context.Context, sq StatsQuery) <-chan AssetStatsRow {
+	return nil
+}
+
+func (db *dummyIndexerDb) StakeTotals(ctx context.Context) (totals StakeTotals, err error) {
+	return
+}
+
 type IndexerFactory interface {
 	Name() string
 	Build(arg string) (IndexerDb, error)
@@ -109,22 +152,37 @@ type TxnRow struct {
 }
 
 // Next returns what should be an opaque string to be returned in the next query to resume where a previous limit left off.
-func (tr TxnRow) Next() string {
-	var b [12]byte
+// descending is the direction results were in, see TransactionFilter.Descending().
+func (tr TxnRow) Next(descending bool) string {
+	var b [13]byte
 	binary.LittleEndian.PutUint64(b[:8], tr.Round)
-	binary.LittleEndian.PutUint32(b[8:], uint32(tr.Intra))
+	binary.LittleEndian.PutUint32(b[8:12], uint32(tr.Intra))
+	if descending {
+		b[12] = byte(TxnOrderDesc)
+	} else {
+		b[12] = byte(TxnOrderAsc)
+	}
 	return base64.URLEncoding.EncodeToString(b[:])
 }
 
 // DecodeTxnRowNext unpacks opaque string returned from TxnRow.Next()
-func DecodeTxnRowNext(s string) (round uint64, intra uint32, err error) {
+func DecodeTxnRowNext(s string) (round uint64, intra uint32, order TxnOrder, err error) {
 	var b []byte
 	b, err = base64.URLEncoding.DecodeString(s)
 	if err != nil {
 		return
 	}
+	if len(b) != 13 {
+		err = fmt.Errorf("txn next token has %d bytes, wanted 13", len(b))
+		return
+	}
+	order = TxnOrder(b[12])
+	if order != TxnOrderAsc && order != TxnOrderDesc {
+		err = fmt.Errorf("txn next token has unknown order %d", b[12])
+		return
+	}
 	round = binary.LittleEndian.Uint64(b[:8])
-	intra = binary.LittleEndian.Uint32(b[8:])
+	intra = binary.LittleEndian.Uint32(b[8:12])
 	return
 }
 
@@ -145,6 +203,11 @@ type IndexerDb interface {
 	MarkImported(path string) (err error)
 
 	LoadGenesis(genesis types.Genesis) (err error)
+	// LoadSnapshot sets account, asset holding, and asset state
+	// as of snapshot.Block.Round and marks accounting as having
+	// been done through that round. The round after it is recorded
+	// as the earliest with transactions.
+	LoadSnapshot(snapshot types.LedgerSnapshot) (err error)
 	SetProto(version string, proto types.ConsensusParams) (err error)
 	GetProto(version string) (proto types.ConsensusParams, err error)
 
@@ -157,12 +220,34 @@ type IndexerDb interface {
 
 	CommitRoundAccounting(updates RoundUpdates, round, rewardsBase uint64) (err error)
 
+	// PruneTransactions deletes transactions that are outside of the
+	// retention policy and have already been applied to account
+	// state. It returns the earliest round for which transactions
+	// are still stored.
+	PruneTransactions(policy RetentionPolicy) (earliestRound uint64, err error)
+
 	GetBlock(round uint64) (block types.Block, err error)
 
 	Transactions(ctx context.Context, tf TransactionFilter) <-chan TxnRow
 	GetAccounts(ctx context.Context, opts AccountQueryOptions) <-chan AccountRow
 	Assets(ctx context.Context, filter AssetsQuery) <-chan AssetRow
 	AssetBalances(ctx context.Context, abq AssetBalanceQuery) <-chan AssetBalanceRow
+	// MultisigAccounts returns the multisig addresses pubkey has been seen signing for.
+	MultisigAccounts(ctx context.Context, pubkey []byte) <-chan MultisigAccountRow
+	// AuthHistory returns the rekeys of addr, oldest first.
+	AuthHistory(ctx context.Context, addr []byte) <-chan AuthHistoryRow
+	// ParticipationHistory returns the keyregs of addr, oldest first.
+	ParticipationHistory(ctx context.Context, addr []byte) <-chan ParticipationHistoryRow
+	// AssetConfigHistory returns the acfgs of an asset, oldest first.
+	AssetConfigHistory(ctx context.Context, assetId uint64) <-chan AssetConfigHistoryRow
+	// AssetSupply sums the holdings of an asset as of the accounting round.
+	AssetSupply(ctx context.Context, assetId uint64) (supply AssetSupply, err error)
+	// StakeTotals sums account balances by status as of the accounting round.
+	StakeTotals(ctx context.Context) (totals StakeTotals, err error)
+	// Stats returns the txn rollups of sq.Interval, newest first.
+	Stats(ctx context.Context, sq StatsQuery) <-chan StatsRow
+	// AssetStats returns the axfer rollups of sq.AssetId for sq.Interval, newest first.
+	AssetStats(ctx context.Context, sq StatsQuery) <-chan AssetStatsRow
 }
 
 func GetAccount(idb IndexerDb, addr []byte) (account models.Account, err error) {
@@ -172,6 +257,20 @@ func GetAccount(idb IndexerDb, addr []byte) (account models.Account, err error)
 	return models.Account{}, nil
 }
 
+// StatusStrings are the Account status values by AccountData.Status
+var StatusStrings = []string{"Offline", "Online", "NotParticipating"}
+
+// PendingRewards are the rewards an account with status {Offline:0,
+// Online:1, NotParticipating: 2} has earned since rewardsBase but not
+// yet been credited, by the rule algod's AccountData.WithUpdatedRewards
+// uses. NotParticipating accounts earn no rewards.
+func PendingRewards(proto types.ConsensusParams, status int, microalgos, rewardsBase, rewardsLevel uint64) uint64 {
+	if status == 2 || proto.RewardUnit == 0 || rewardsLevel < rewardsBase {
+		return 0
+	}
+	return (microalgos / proto.RewardUnit) * (rewardsLevel - rewardsBase)
+}
+
 // TransactionFilter.AddressRole bitfield values
 const (
 	AddressRoleSender           = 0x01
@@ -180,16 +279,105 @@ const (
 	AddressRoleAssetSender      = 0x08
 	AddressRoleAssetReceiver    = 0x10
 	AddressRoleAssetCloseTo     = 0x20
-	AddressRoleFreeze           = 0x40
+	AddressRoleFreeze           = 0x40 // afrz FreezeAccount
+	AddressRoleConfigManager    = 0x80 // acfg AssetParams.Manager
+	AddressRoleConfigReserve    = 0x100
+	AddressRoleConfigFreeze     = 0x200
+	AddressRoleConfigClawback   = 0x400
+	AddressRoleRekeyTo          = 0x800
+)
+
+// AddressRoles returns the AddressRole* bits for the ways addr appears in stxn.
+func AddressRoles(stxn *types.SignedTxnWithAD, addr []byte) (roles uint64) {
+	var a atypes.Address
+	if len(addr) != len(a) {
+		return 0
+	}
+	copy(a[:], addr)
+	if a == stxn.Txn.Sender {
+		roles |= AddressRoleSender
+	}
+	if a == stxn.Txn.Receiver {
+		roles |= AddressRoleReceiver
+	}
+	if a == stxn.Txn.CloseRemainderTo {
+		roles |= AddressRoleCloseRemainderTo
+	}
+	if a == stxn.Txn.AssetSender {
+		roles |= AddressRoleAssetSender
+	}
+	if a == stxn.Txn.AssetReceiver {
+		roles |= AddressRoleAssetReceiver
+	}
+	if a == stxn.Txn.AssetCloseTo {
+		roles |= AddressRoleAssetCloseTo
+	}
+	if a == stxn.Txn.FreezeAccount {
+		roles |= AddressRoleFreeze
+	}
+	if a == stxn.Txn.AssetParams.Manager {
+		roles |= AddressRoleConfigManager
+	}
+	if a == stxn.Txn.AssetParams.Reserve {
+		roles |= AddressRoleConfigReserve
+	}
+	if a == stxn.Txn.AssetParams.Freeze {
+		roles |= AddressRoleConfigFreeze
+	}
+	if a == stxn.Txn.AssetParams.Clawback {
+		roles |= AddressRoleConfigClawback
+	}
+	if a == stxn.Txn.RekeyTo {
+		roles |= AddressRoleRekeyTo
+	}
+	return
+}
+
+// SigTypeOf returns "sig", "msig", or "lsig" for how stxn was signed, or "" if it has no signature.
+func SigTypeOf(stxn *types.SignedTxnWithAD) string {
+	for _, b := range stxn.Sig {
+		if b != 0 {
+			return "sig"
+		}
+	}
+	if !stxn.Msig.Blank() {
+		return "msig"
+	}
+	if len(stxn.Lsig.Logic) != 0 {
+		return "lsig"
+	}
+	return ""
+}
+
+// Transaction storage modes, kept in metastate "txn_storage".
+const (
+	// TxnStorageFull keeps both the msgpack and JSON encoding of each transaction.
+	TxnStorageFull = "full"
+
+	// TxnStorageMsgpack keeps only the msgpack encoding. Searchable fields are in their own columns either way.
+	TxnStorageMsgpack = "msgpack"
+)
+
+// TxnOrder is the (round, intra) order of TransactionFilter results.
+type TxnOrder int
+
+const (
+	// TxnOrderDefault is newest-first when filtering by Address and oldest-first otherwise.
+	TxnOrderDefault TxnOrder = iota
+	TxnOrderAsc
+	TxnOrderDesc
 )
 
 type TransactionFilter struct {
-	// Address filtering transactions for one Address will
-	// return transactions newest-first proceding into the
-	// past. Paging through such results can be achieved by
+	// Address filtering transactions for one Address will, by
+	// default, return transactions newest-first proceding into
+	// the past. Paging through such results can be achieved by
 	// setting a MaxRound to get results before.
 	Address []byte
 
+	// Order overrides the default order of results.
+	Order TxnOrder
+
 	AddressRole uint64 // 0=Any, otherwise AddressRole* bitfields above
 
 	MinRound   uint64
@@ -207,6 +395,11 @@ type TransactionFilter struct {
 	AlgosGT    uint64 // implictly filters on "pay" txns for Algos > this. This will be a slightly faster query than EffectiveAmountGt.
 	AlgosLT    uint64
 	RekeyTo    *bool // nil for no filter
+	GroupId    []byte
+	// LsigProgramHash is the program address, crypto.AddressFromProgram(), of a logic sig
+	LsigProgramHash []byte
+	// MsigParticipant is the public key of one of the signers of a multisig
+	MsigParticipant []byte
 
 	AssetId       uint64 // filter transactions relevant to an asset
 	AssetAmountGT uint64
@@ -221,21 +414,58 @@ type TransactionFilter struct {
 	Limit uint64
 }
 
+// Descending returns true if results are newest-first.
+func (tf TransactionFilter) Descending() bool {
+	switch tf.Order {
+	case TxnOrderAsc:
+		return false
+	case TxnOrderDesc:
+		return true
+	default:
+		return tf.Address != nil
+	}
+}
+
+// RetentionPolicy says how much transaction history to keep. A
+// transaction is deleted when it is older than either limit. The zero
+// value keeps everything.
+type RetentionPolicy struct {
+	// Rounds keeps transactions from this many of the most recent rounds.
+	Rounds uint64
+
+	// Age keeps transactions from blocks newer than this.
+	Age time.Duration
+}
+
 type AccountQueryOptions struct {
 	GreaterThanAddress []byte // for paging results
 	EqualToAddress     []byte // return exactly this one account
 
 	// return any accounts with this auth addr
 	EqualToAuthAddr []byte
+	// return any accounts that have ever been rekeyed to this auth addr
+	EverAuthAddr []byte
+
+	// IncludeClosed includes closed accounts and asset holdings
+	// that were closed out, which are otherwise skipped.
+	IncludeClosed bool
+	// ActiveSince filters on accounts that sent a txn in this round or later.
+	ActiveSince uint64
+
+	// Status filters on AccountData.Status {Offline:0, Online:1, NotParticipating: 2}
+	Status *int
+	// VoteLastBefore filters on online accounts with a participation
+	// key last valid before this round.
+	VoteLastBefore uint64
 
 	// Filter on accounts with current balance greater than x
 	AlgosGreaterThan uint64
 	// Filter on accounts with current balance less than x.
 	AlgosLessThan uint64
 
-	// HasAssetId, AssetGT, and AssetLT are implemented in Go code
-	// after data has returned from Postgres and thus are slightly
-	// less efficient. They will turn on IncludeAssetHoldings.
+	// HasAssetId filters on accounts holding (or opted in to) an
+	// asset, optionally with an amount greater than AssetGT and/or
+	// less than AssetLT.
 	HasAssetId uint64
 	AssetGT    uint64
 	AssetLT    uint64
@@ -243,6 +473,14 @@ type AccountQueryOptions struct {
 	IncludeAssetHoldings bool
 	IncludeAssetParams   bool
 
+	// OrderByBalance returns the largest microalgos balance first,
+	// ties by descending address. Paging is by BalanceBefore and
+	// AddressBefore, the last item of the previous query, instead of
+	// GreaterThanAddress.
+	OrderByBalance bool
+	BalanceBefore  uint64
+	AddressBefore  []byte
+
 	Limit uint64
 }
 
@@ -257,22 +495,55 @@ type AssetsQuery struct {
 
 	Creator []byte
 
-	// Name is a case insensitive substring comparison of the asset name
+	// Manager, Reserve, Freeze and Clawback match the current role
+	// addresses of the asset params.
+	Manager  []byte
+	Reserve  []byte
+	Freeze   []byte
+	Clawback []byte
+
+	// Name is a case insensitive comparison of the asset name
 	Name string
-	// Unit is a case insensitive substring comparison of the asset unit
+	// Unit is a case insensitive comparison of the asset unit
 	Unit string
-	// Query checks for fuzzy match against either asset name or unit name
-	// (assetname ILIKE '%?%' OR unitname ILIKE '%?%')
+	// Query checks for a match against any of asset name, unit name or URL
+	// (assetname ILIKE '%?%' OR unitname ILIKE '%?%' OR url ILIKE '%?%')
 	Query string
+	// Match is how Name, Unit and Query compare, substring by default.
+	Match AssetMatch
+
+	// OrderByRelevance returns the assets most similar to Name,
+	// Unit and Query first instead of by AssetId.
+	// AssetIdGreaterThan doesn't apply to it.
+	OrderByRelevance bool
+
+	// IncludeDeleted includes destroyed assets, which are skipped
+	// by default.
+	IncludeDeleted bool
 
 	Limit uint64
 }
 
+// AssetMatch is how AssetsQuery text filters compare, case insensitive.
+type AssetMatch int
+
+const (
+	// AssetMatchSubstring matches the text anywhere.
+	AssetMatchSubstring AssetMatch = iota
+	// AssetMatchPrefix matches the start.
+	AssetMatchPrefix
+	// AssetMatchExact matches all of it.
+	AssetMatchExact
+)
+
 type AssetRow struct {
-	AssetId uint64
-	Creator []byte
-	Params  types.AssetParams
-	Error   error
+	AssetId        uint64
+	Creator        []byte
+	Params         types.AssetParams
+	CreatedRound   *uint64
+	DestroyedRound *uint64
+	Deleted        bool
+	Error          error
 }
 
 type AssetBalanceQuery struct {
@@ -285,6 +556,15 @@ type AssetBalanceQuery struct {
 	// PrevAddress for paging, the last item from the previous
 	// query (items returned in address order)
 	PrevAddress []byte
+
+	// OrderByAmount returns the largest holding first, ties by
+	// descending address. PrevAmount and PrevAddress are then the
+	// last item from the previous query.
+	OrderByAmount bool
+	PrevAmount    uint64
+
+	// IncludeClosed includes holdings that were closed out.
+	IncludeClosed bool
 }
 
 type AssetBalanceRow struct {
@@ -292,6 +572,171 @@ type AssetBalanceRow struct {
 	AssetId uint64
 	Amount  uint64
 	Frozen  bool
+
+	// rounds of the opt in and last opt out, nil if unknown or never
+	CreatedRound *uint64
+	ClosedRound  *uint64
+	Deleted      bool
+
+	Error error
+}
+
+type MultisigAccountRow struct {
+	Address    []byte
+	Version    uint8
+	Threshold  uint8
+	PublicKeys [][]byte
+	Error      error
+}
+
+// ParticipationHistoryRow is one keyreg of an account. The keys and
+// validity are only set when Status is Online.
+type ParticipationHistoryRow struct {
+	Round           uint64
+	Intra           int
+	Txid            string
+	Status          int // {Offline:0, Online:1, NotParticipating: 2}
+	VoteKey         []byte
+	SelectionKey    []byte
+	VoteFirst       uint64
+	VoteLast        uint64
+	VoteKeyDilution uint64
+	Error           error
+}
+
+// StakeTotals are microalgos, including pending rewards, held by
+// accounts of each status.
+type StakeTotals struct {
+	Round            uint64 // accounting round the totals are for
+	Online           uint64
+	Offline          uint64
+	NotParticipating uint64
+}
+
+// AssetSupply is how the units of an asset are held.
+type AssetSupply struct {
+	Round       uint64 // accounting round the supply is for
+	Total       uint64
+	Reserve     uint64 // held by the reserve address
+	Creator     uint64 // held by the creator
+	Frozen      uint64 // held in frozen holdings
+	Holders     uint64 // holdings with a balance
+	ZeroBalance uint64 // opted in holdings without a balance
+}
+
+// RoundStats are the totals of a round's txns, rolled up by
+// CommitRoundAccounting with the accounts it opened and made active.
+type RoundStats struct {
+	TxnCounts  map[string]uint64 // by txn type
+	AlgoVolume uint64            // pay amounts and close amounts
+	Fees       uint64
+
+	// AssetTransfers and AssetVolume are the axfers and the units
+	// they sent by asset, not counting close outs.
+	AssetTransfers map[uint64]uint64
+	AssetVolume    map[uint64]*big.Int
+}
+
+// Add counts stxn into the stats: its type and fee, the amount and
+// close amount of a pay, and the transfer and amount of an axfer.
+func (stats *RoundStats) Add(stxn *types.SignedTxnWithAD) {
+	if stats.TxnCounts == nil {
+		stats.TxnCounts = make(map[string]uint64)
+	}
+	stats.TxnCounts[string(stxn.Txn.Type)]++
+	stats.Fees += uint64(stxn.Txn.Fee)
+	switch stxn.Txn.Type {
+	case "pay":
+		stats.AlgoVolume += uint64(stxn.Txn.Amount) + uint64(stxn.ClosingAmount)
+	case "axfer":
+		if stats.AssetTransfers == nil {
+			stats.AssetTransfers = make(map[uint64]uint64)
+			stats.AssetVolume = make(map[uint64]*big.Int)
+		}
+		assetId := uint64(stxn.Txn.XferAsset)
+		stats.AssetTransfers[assetId]++
+		// many axfers of an asset can send more than fits in a uint64
+		volume := stats.AssetVolume[assetId]
+		if volume == nil {
+			volume = new(big.Int)
+			stats.AssetVolume[assetId] = volume
+		}
+		volume.Add(volume, new(big.Int).SetUint64(stxn.Txn.AssetAmount))
+	}
+}
+
+// StatsTxnTypes are the txn types counted in stats rollups.
+var StatsTxnTypes = []string{"pay", "keyreg", "acfg", "axfer", "afrz"}
+
+// StatsInterval is the length of a stats rollup.
+type StatsInterval int
+
+const (
+	StatsIntervalRound StatsInterval = iota
+	StatsIntervalHour
+	StatsIntervalDay
+)
+
+// StatsQuery selects stats rollups. Rollups are filtered by the
+// rounds they span and by the time they start.
+type StatsQuery struct {
+	Interval StatsInterval
+	AssetId  uint64 // for AssetStats
+
+	MinRound   uint64
+	MaxRound   uint64
+	AfterTime  time.Time
+	BeforeTime time.Time
+
+	Limit uint64
+}
+
+// StatsRow is the txn rollup of rounds FirstRound through LastRound,
+// one round for StatsIntervalRound.
+type StatsRow struct {
+	FirstRound     uint64
+	LastRound      uint64
+	Start          time.Time
+	Txns           uint64
+	TxnCounts      map[string]uint64 // by txn type
+	AlgoVolume     uint64
+	Fees           uint64
+	NewAccounts    uint64 // opened or reopened
+	ActiveAccounts uint64 // distinct senders
+	Error          error
+}
+
+// AssetStatsRow is the axfer rollup of an asset for rounds FirstRound
+// through LastRound.
+type AssetStatsRow struct {
+	FirstRound uint64
+	LastRound  uint64
+	Start      time.Time
+	Transfers  uint64
+	Volume     uint64
+	Error      error
+}
+
+// AuthHistoryRow is one rekey of an account. OldAuth and NewAuth are
+// nil when the account was or becomes authorized by its own key.
+type AuthHistoryRow struct {
+	Round   uint64
+	Intra   int
+	Txid    string
+	OldAuth []byte
+	NewAuth []byte
+	Error   error
+}
+
+// AssetConfigHistoryRow is one acfg of an asset. Params is nil when
+// the acfg destroyed the asset.
+type AssetConfigHistoryRow struct {
+	Round   uint64
+	Intra   int
+	Txid    string
+	Sender  []byte
+	Creator []byte
+	Params  *types.AssetParams
 	Error   error
 }
 
@@ -331,12 +776,63 @@ type AccountDataUpdate struct {
 	VoteKeyDilution uint64
 }
 
+// NewKeyregUpdate is the participation registered by a keyreg txn: online
+// with its keys and validity when it has both a vote and selection key,
+// otherwise offline, or not participating if it asks to be. See
+// https://github.com/algorand/go-algorand/blob/master/data/transactions/keyreg.go
+func NewKeyregUpdate(stxn *types.SignedTxnWithAD, round uint64, intra int) KeyregUpdate {
+	kr := KeyregUpdate{
+		Addr:  stxn.Txn.Sender,
+		Round: round,
+		Intra: intra,
+		Txid:  crypto.TransactionIDString(stxn.Txn),
+	}
+	if stxn.Txn.VotePK == (atypes.VotePK{}) || stxn.Txn.SelectionPK == (atypes.VRFPK{}) {
+		if stxn.Txn.Nonparticipation {
+			kr.Status = 2
+		}
+		return kr
+	}
+	kr.Status = 1
+	kr.VoteKey = stxn.Txn.VotePK[:]
+	kr.SelectionKey = stxn.Txn.SelectionPK[:]
+	kr.VoteFirst = uint64(stxn.Txn.VoteFirst)
+	kr.VoteLast = uint64(stxn.Txn.VoteLast)
+	kr.VoteKeyDilution = stxn.Txn.VoteKeyDilution
+	return kr
+}
+
+// AcfgUpdate sets the params of AssetId. For an existing asset Params
+// only has the role addresses, see RoleParams.
 type AcfgUpdate struct {
 	AssetId uint64
 	Creator types.Address
 	Params  types.AssetParams
 }
 
+// RoleParams is the manager, reserve, freeze and clawback addresses of
+// params, the only ones an acfg of an existing asset changes.
+func RoleParams(params types.AssetParams) types.AssetParams {
+	return types.AssetParams{
+		Manager:  params.Manager,
+		Reserve:  params.Reserve,
+		Freeze:   params.Freeze,
+		Clawback: params.Clawback,
+	}
+}
+
+// AssetConfigUpdate is an acfg of AssetId by Sender. Destroy acfgs
+// have zero Params, and those of an existing asset only the role
+// addresses as in AcfgUpdate.
+type AssetConfigUpdate struct {
+	AssetId uint64
+	Sender  types.Address
+	Params  types.AssetParams
+	Round   uint64
+	Intra   int
+	Txid    string
+}
+
 type AssetUpdate struct {
 	AssetId       uint64
 	Delta         big.Int
@@ -364,10 +860,64 @@ type TxnAssetUpdate struct {
 	AssetId uint64
 }
 
+// KeyregUpdate is the participation Addr registered with a keyreg
+// txn. The keys and validity are only set when Status is Online.
+type KeyregUpdate struct {
+	Addr            types.Address
+	Round           uint64
+	Intra           int
+	Txid            string
+	Status          int // {Offline:0, Online:1, NotParticipating: 2}
+	VoteKey         []byte
+	SelectionKey    []byte
+	VoteFirst       uint64
+	VoteLast        uint64
+	VoteKeyDilution uint64
+}
+
+// AuthUpdate is a rekey of Addr to AuthAddr.
+type AuthUpdate struct {
+	Addr     types.Address
+	AuthAddr types.Address
+	Round    uint64
+	Intra    int
+	Txid     string
+}
+
 type RoundUpdates struct {
 	AlgoUpdates  map[[32]byte]int64
 	AccountTypes map[[32]byte]string
 
+	// LsigPrograms is the logic of escrow accounts, recorded when
+	// they are first seen sending.
+	LsigPrograms map[[32]byte][]byte
+
+	// AuthUpdates are the rekeys of the round in txn order, kept as
+	// history in addition to the "spend" AccountDataUpdates.
+	AuthUpdates []AuthUpdate
+
+	// ActiveAccounts are the senders of this round's txns.
+	ActiveAccounts map[[32]byte]bool
+
+	// AccountCloses are the senders that closed their account with
+	// CloseRemainderTo this round.
+	AccountCloses map[[32]byte]bool
+
+	// RewardsUpdates are the rewards credited to accounts this
+	// round, added to AccountData.RewardedMicroAlgos.
+	RewardsUpdates map[[32]byte]uint64
+
+	// KeyregUpdates are the keyregs of the round in txn order, kept
+	// as history in addition to the AccountDataUpdates.
+	KeyregUpdates []KeyregUpdate
+
+	// Stats are the txn totals of the round for the stats rollups.
+	Stats RoundStats
+
+	// AssetConfigUpdates are the acfgs of the round in txn order,
+	// kept as history in addition to AcfgUpdates and AssetDestroys.
+	AssetConfigUpdates []AssetConfigUpdate
+
 	// AccountDataUpdates is explicitly a map so that we can
 	// explicitly set values or have not set values. Instead of
 	// using msgpack or JSON serialization of a struct, each field
@@ -67,6 +67,59 @@ func (_m *IndexerDb) AssetBalances(ctx context.Context, abq idb.AssetBalanceQuer
 	return r0
 }
 
+// AssetConfigHistory provides a mock function with given fields: ctx, assetId
+func (_m *IndexerDb) AssetConfigHistory(ctx context.Context, assetId uint64) <-chan idb.AssetConfigHistoryRow {
+	ret := _m.Called(ctx, assetId)
+
+	var r0 <-chan idb.AssetConfigHistoryRow
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) <-chan idb.AssetConfigHistoryRow); ok {
+		r0 = rf(ctx, assetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.AssetConfigHistoryRow)
+		}
+	}
+
+	return r0
+}
+
+// AssetStats provides a mock function with given fields: ctx, sq
+func (_m *IndexerDb) AssetStats(ctx context.Context, sq idb.StatsQuery) <-chan idb.AssetStatsRow {
+	ret := _m.Called(ctx, sq)
+
+	var r0 <-chan idb.AssetStatsRow
+	if rf, ok := ret.Get(0).(func(context.Context, idb.StatsQuery) <-chan idb.AssetStatsRow); ok {
+		r0 = rf(ctx, sq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.AssetStatsRow)
+		}
+	}
+
+	return r0
+}
+
+// AssetSupply provides a mock function with given fields: ctx, assetId
+func (_m *IndexerDb) AssetSupply(ctx context.Context, assetId uint64) (idb.AssetSupply, error) {
+	ret := _m.Called(ctx, assetId)
+
+	var r0 idb.AssetSupply
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) idb.AssetSupply); ok {
+		r0 = rf(ctx, assetId)
+	} else {
+		r0 = ret.Get(0).(idb.AssetSupply)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, assetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Assets provides a mock function with given fields: ctx, filter
 func (_m *IndexerDb) Assets(ctx context.Context, filter idb.AssetsQuery) <-chan idb.AssetRow {
 	ret := _m.Called(ctx, filter)
@@ -83,6 +136,22 @@ func (_m *IndexerDb) Assets(ctx context.Context, filter idb.AssetsQuery) <-chan
 	return r0
 }
 
+// AuthHistory provides a mock function with given fields: ctx, addr
+func (_m *IndexerDb) AuthHistory(ctx context.Context, addr []byte) <-chan idb.AuthHistoryRow {
+	ret := _m.Called(ctx, addr)
+
+	var r0 <-chan idb.AuthHistoryRow
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) <-chan idb.AuthHistoryRow); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.AuthHistoryRow)
+		}
+	}
+
+	return r0
+}
+
 // CommitBlock provides a mock function with given fields: round, timestamp, rewardslevel, headerbytes
 func (_m *IndexerDb) CommitBlock(round uint64, timestamp int64, rewardslevel uint64, headerbytes []byte) error {
 	ret := _m.Called(round, timestamp, rewardslevel, headerbytes)
@@ -225,6 +294,20 @@ func (_m *IndexerDb) LoadGenesis(genesis types.Genesis) error {
 	return r0
 }
 
+// LoadSnapshot provides a mock function with given fields: snapshot
+func (_m *IndexerDb) LoadSnapshot(snapshot types.LedgerSnapshot) error {
+	ret := _m.Called(snapshot)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.LedgerSnapshot) error); ok {
+		r0 = rf(snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // MarkImported provides a mock function with given fields: path
 func (_m *IndexerDb) MarkImported(path string) error {
 	ret := _m.Called(path)
@@ -239,6 +322,59 @@ func (_m *IndexerDb) MarkImported(path string) error {
 	return r0
 }
 
+// MultisigAccounts provides a mock function with given fields: ctx, pubkey
+func (_m *IndexerDb) MultisigAccounts(ctx context.Context, pubkey []byte) <-chan idb.MultisigAccountRow {
+	ret := _m.Called(ctx, pubkey)
+
+	var r0 <-chan idb.MultisigAccountRow
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) <-chan idb.MultisigAccountRow); ok {
+		r0 = rf(ctx, pubkey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.MultisigAccountRow)
+		}
+	}
+
+	return r0
+}
+
+// ParticipationHistory provides a mock function with given fields: ctx, addr
+func (_m *IndexerDb) ParticipationHistory(ctx context.Context, addr []byte) <-chan idb.ParticipationHistoryRow {
+	ret := _m.Called(ctx, addr)
+
+	var r0 <-chan idb.ParticipationHistoryRow
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) <-chan idb.ParticipationHistoryRow); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.ParticipationHistoryRow)
+		}
+	}
+
+	return r0
+}
+
+// PruneTransactions provides a mock function with given fields: policy
+func (_m *IndexerDb) PruneTransactions(policy idb.RetentionPolicy) (uint64, error) {
+	ret := _m.Called(policy)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(idb.RetentionPolicy) uint64); ok {
+		r0 = rf(policy)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(idb.RetentionPolicy) error); ok {
+		r1 = rf(policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetMetastate provides a mock function with given fields: key, jsonStrValue
 func (_m *IndexerDb) SetMetastate(key string, jsonStrValue string) error {
 	ret := _m.Called(key, jsonStrValue)
@@ -267,6 +403,27 @@ func (_m *IndexerDb) SetProto(version string, proto types.ConsensusParams) error
 	return r0
 }
 
+// StakeTotals provides a mock function with given fields: ctx
+func (_m *IndexerDb) StakeTotals(ctx context.Context) (idb.StakeTotals, error) {
+	ret := _m.Called(ctx)
+
+	var r0 idb.StakeTotals
+	if rf, ok := ret.Get(0).(func(context.Context) idb.StakeTotals); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(idb.StakeTotals)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StartBlock provides a mock function with given fields:
 func (_m *IndexerDb) StartBlock() error {
 	ret := _m.Called()
@@ -281,6 +438,22 @@ func (_m *IndexerDb) StartBlock() error {
 	return r0
 }
 
+// Stats provides a mock function with given fields: ctx, sq
+func (_m *IndexerDb) Stats(ctx context.Context, sq idb.StatsQuery) <-chan idb.StatsRow {
+	ret := _m.Called(ctx, sq)
+
+	var r0 <-chan idb.StatsRow
+	if rf, ok := ret.Get(0).(func(context.Context, idb.StatsQuery) <-chan idb.StatsRow); ok {
+		r0 = rf(ctx, sq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan idb.StatsRow)
+		}
+	}
+
+	return r0
+}
+
 // Transactions provides a mock function with given fields: ctx, tf
 func (_m *IndexerDb) Transactions(ctx context.Context, tf idb.TransactionFilter) <-chan idb.TxnRow {
 	ret := _m.Called(ctx, tf)
@@ -7,6 +7,7 @@ package idb
 //go:generate go run ../cmd/texttosource/main.go idb setup_postgres.sql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/base64"
@@ -14,6 +15,7 @@ import (
 	"math"
 	"math/big"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,7 +41,11 @@ func OpenPostgres(connection string) (pdb *PostgresIndexerDb, err error) {
 	// e.g. a user named "readonly" is in the connection string
 	if !strings.Contains(connection, "readonly") {
 		err = pdb.init()
+		if err != nil {
+			return
+		}
 	}
+	err = pdb.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm')`).Scan(&pdb.trgm)
 	return
 }
 
@@ -53,16 +59,50 @@ type PostgresIndexerDb struct {
 
 	txrows  [][]interface{}
 	txprows [][]interface{}
+	txmrows [][]interface{} // txn_msig
+	msrows  [][]interface{} // msig_account
+
+	// TxnStorageFull or TxnStorageMsgpack, loaded from metastate by StartBlock
+	txnStorage string
 
 	protoCache map[string]types.ConsensusParams
+
+	// trgm is set when the pg_trgm extension is installed, see setupTrigramSearch
+	trgm bool
 }
 
 func (db *PostgresIndexerDb) init() (err error) {
 	_, err = db.db.Exec(setup_postgres_sql)
+	if err != nil {
+		return
+	}
+	err = db.setupTrigramSearch()
+	if err != nil {
+		return
+	}
 
-	// TODO: Schema-migration/Upgrade. Select upgrade state from database and compare to code, apply upgrades from code to database state.
-	// upgradeJson, err := db.GetMetastate("upgrade-state")
-	return
+	// setup_postgres.sql adds tables and columns, migrations fill in data for them.
+	return db.migrate()
+}
+
+// setupTrigramSearch indexes asset names, units and URLs with pg_trgm
+// trigrams, which serve ILIKE of any AssetMatch and similarity() of
+// OrderByRelevance. Hosted Postgres may not let the indexer create the
+// extension, in which case asset search is done by unindexed ILIKE and
+// not ranked.
+func (db *PostgresIndexerDb) setupTrigramSearch() error {
+	_, err := db.db.Exec(`CREATE EXTENSION IF NOT EXISTS pg_trgm`)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "pg_trgm extension not available, asset search will not be indexed or ranked: %v\n", err)
+		return nil
+	}
+	_, err = db.db.Exec(`CREATE INDEX IF NOT EXISTS asset_name_trgm ON asset USING gin ( (params ->> 'an') gin_trgm_ops );
+CREATE INDEX IF NOT EXISTS asset_unit_trgm ON asset USING gin ( (params ->> 'un') gin_trgm_ops );
+CREATE INDEX IF NOT EXISTS asset_url_trgm ON asset USING gin ( (params ->> 'au') gin_trgm_ops );`)
+	if err != nil {
+		return fmt.Errorf("asset trigram indexes, %v", err)
+	}
+	return nil
 }
 
 func (db *PostgresIndexerDb) AlreadyImported(path string) (imported bool, err error) {
@@ -80,31 +120,121 @@ func (db *PostgresIndexerDb) MarkImported(path string) (err error) {
 func (db *PostgresIndexerDb) StartBlock() (err error) {
 	db.txrows = make([][]interface{}, 0, 6000)
 	db.txprows = make([][]interface{}, 0, 10000)
-	return nil
+	db.txmrows = nil
+	db.msrows = nil
+	if db.txnStorage == "" {
+		db.txnStorage, err = db.getTxnStorage()
+	}
+	return
+}
+
+func (db *PostgresIndexerDb) getTxnStorage() (mode string, err error) {
+	modeJsonStr, err := db.GetMetastate("txn_storage")
+	if err != nil {
+		return "", fmt.Errorf("getting txn storage mode, %v", err)
+	}
+	if modeJsonStr == "" {
+		return TxnStorageFull, nil
+	}
+	err = json.Decode([]byte(modeJsonStr), &mode)
+	if err != nil {
+		return "", fmt.Errorf("parsing txn storage mode, %v", err)
+	}
+	return
+}
+
+// txnColumns returns the values of the txn columns amount, closeamount, assetamount, note, sigtype, rekeyto, txgroup, lsighash.
+func txnColumns(stxn *types.SignedTxnWithAD) []interface{} {
+	var amount, closeamount, assetamount, note, sigtype, rekeyto, txgroup, lsighash interface{}
+	switch stxn.Txn.Type {
+	case atypes.PaymentTx:
+		amount = uint64(stxn.Txn.Amount)
+		if stxn.ClosingAmount != 0 {
+			closeamount = uint64(stxn.ClosingAmount)
+		}
+	case atypes.AssetTransferTx:
+		// numeric(20) because uint64 doesn't fit in bigint
+		assetamount = strconv.FormatUint(stxn.Txn.AssetAmount, 10)
+	}
+	if len(stxn.Txn.Note) > 0 {
+		note = stxn.Txn.Note
+	}
+	if st := SigTypeOf(stxn); st != "" {
+		sigtype = st
+	}
+	if !stxn.Txn.RekeyTo.IsZero() {
+		rekeyto = stxn.Txn.RekeyTo[:]
+	}
+	if stxn.Txn.Group != (atypes.Digest{}) {
+		txgroup = stxn.Txn.Group[:]
+	}
+	if len(stxn.Lsig.Logic) != 0 {
+		ph := crypto.AddressFromProgram(stxn.Lsig.Logic)
+		lsighash = ph[:]
+	}
+	return []interface{}{amount, closeamount, assetamount, note, sigtype, rekeyto, txgroup, lsighash}
 }
 
 func (db *PostgresIndexerDb) AddTransaction(round uint64, intra int, txtypeenum int, assetid uint64, txn types.SignedTxnWithAD, participation [][]byte) error {
 	txnbytes := msgpack.Encode(txn)
 	txid := crypto.TransactionIDString(txn.Txn)
-	tx := []interface{}{round, intra, txtypeenum, assetid, txid[:], txnbytes, string(json.Encode(txn))}
+	var txnjson interface{}
+	if db.txnStorage != TxnStorageMsgpack {
+		txnjson = string(json.Encode(txn))
+	}
+	tx := []interface{}{round, intra, txtypeenum, assetid, txid[:], txnbytes, txnjson}
+	tx = append(tx, txnColumns(&txn)...)
 	db.txrows = append(db.txrows, tx)
 	for _, paddr := range participation {
 		seen := false
 		if !seen {
-			txp := []interface{}{paddr, round, intra}
+			txp := []interface{}{paddr, round, intra, AddressRoles(&txn, paddr)}
 			db.txprows = append(db.txprows, txp)
 		}
 	}
+	txmrows, msrows := msigRows(&txn, round, intra)
+	db.txmrows = append(db.txmrows, txmrows...)
+	db.msrows = append(db.msrows, msrows...)
 	return nil
 }
 
+// msigRows returns txn_msig and msig_account rows for the multisig that
+// signed stxn, directly or through a logic sig, if any.
+func msigRows(stxn *types.SignedTxnWithAD, round uint64, intra int) (txmrows, msrows [][]interface{}) {
+	msig := stxn.Msig
+	if msig.Blank() {
+		msig = stxn.Lsig.Msig
+	}
+	if msig.Blank() {
+		return nil, nil
+	}
+	ma, err := crypto.MultisigAccountFromSig(msig)
+	if err != nil {
+		// can't happen in a valid block
+		return nil, nil
+	}
+	addr, err := ma.Address()
+	if err != nil {
+		return nil, nil
+	}
+	seen := make(map[string]bool, len(ma.Pks))
+	for i, pk := range ma.Pks {
+		msrows = append(msrows, []interface{}{addr[:], i, []byte(pk), ma.Version, ma.Threshold})
+		if !seen[string(pk)] {
+			seen[string(pk)] = true
+			txmrows = append(txmrows, []interface{}{[]byte(pk), round, intra})
+		}
+	}
+	return
+}
+
 func (db *PostgresIndexerDb) CommitBlock(round uint64, timestamp int64, rewardslevel uint64, headerbytes []byte) error {
 	tx, err := db.db.BeginTx(context.Background(), nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback() // ignored if already committed
-	addtx, err := tx.Prepare(`COPY txn (round, intra, typeenum, asset, txid, txnbytes, txn) FROM STDIN`)
+	addtx, err := tx.Prepare(`COPY txn (round, intra, typeenum, asset, txid, txnbytes, txn, amount, closeamount, assetamount, note, sigtype, rekeyto, txgroup, lsighash) FROM STDIN`)
 	if err != nil {
 		return err
 	}
@@ -124,7 +254,7 @@ func (db *PostgresIndexerDb) CommitBlock(round uint64, timestamp int64, rewardsl
 		return err
 	}
 
-	addtxpart, err := tx.Prepare(`COPY txn_participation (addr, round, intra) FROM STDIN`)
+	addtxpart, err := tx.Prepare(`COPY txn_participation (addr, round, intra, role) FROM STDIN`)
 	if err != nil {
 		return err
 	}
@@ -149,6 +279,40 @@ func (db *PostgresIndexerDb) CommitBlock(round uint64, timestamp int64, rewardsl
 		return fmt.Errorf("during addtxp close %v", err)
 	}
 
+	if len(db.txmrows) > 0 {
+		addtxmsig, err := tx.Prepare(`COPY txn_msig (pubkey, round, intra) FROM STDIN`)
+		if err != nil {
+			return err
+		}
+		defer addtxmsig.Close()
+		for _, txmr := range db.txmrows {
+			_, err = addtxmsig.Exec(txmr...)
+			if err != nil {
+				return fmt.Errorf("txn_msig row %#v, %v", txmr, err)
+			}
+		}
+		_, err = addtxmsig.Exec()
+		if err != nil {
+			return fmt.Errorf("during txn_msig empty exec %v", err)
+		}
+		err = addtxmsig.Close()
+		if err != nil {
+			return fmt.Errorf("during txn_msig close %v", err)
+		}
+
+		addmsig, err := tx.Prepare(`INSERT INTO msig_account (addr, idx, pubkey, version, threshold) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`)
+		if err != nil {
+			return err
+		}
+		defer addmsig.Close()
+		for _, msr := range db.msrows {
+			_, err = addmsig.Exec(msr...)
+			if err != nil {
+				return fmt.Errorf("msig_account row %#v, %v", msr, err)
+			}
+		}
+	}
+
 	var block types.Block
 	err = msgpack.Decode(headerbytes, &block)
 	if err != nil {
@@ -163,6 +327,8 @@ func (db *PostgresIndexerDb) CommitBlock(round uint64, timestamp int64, rewardsl
 	err = tx.Commit()
 	db.txrows = nil
 	db.txprows = nil
+	db.txmrows = nil
+	db.msrows = nil
 	if err != nil {
 		return fmt.Errorf("on commit, %v", err)
 	}
@@ -207,7 +373,7 @@ func (db *PostgresIndexerDb) LoadGenesis(genesis types.Genesis) (err error) {
 	}
 	defer tx.Rollback() // ignored if .Commit() first
 
-	setAccount, err := tx.Prepare(`INSERT INTO account (addr, microalgos, rewardsbase, account_data) VALUES ($1, $2, 0, $3)`)
+	setAccount, err := tx.Prepare(`INSERT INTO account (addr, microalgos, rewardsbase, account_data, created_at) VALUES ($1, $2, 0, $3, 0)`)
 	if err != nil {
 		return
 	}
@@ -231,6 +397,86 @@ func (db *PostgresIndexerDb) LoadGenesis(genesis types.Genesis) (err error) {
 
 }
 
+func (db *PostgresIndexerDb) LoadSnapshot(snapshot types.LedgerSnapshot) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return
+	}
+	defer tx.Rollback() // ignored if .Commit() first
+
+	round := uint64(snapshot.Block.Round)
+	headerjson := json.Encode(types.Block{BlockHeader: snapshot.Block})
+	_, err = tx.Exec(`INSERT INTO block_header (round, realtime, rewardslevel, header) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`, round, time.Unix(snapshot.Block.TimeStamp, 0), snapshot.Block.RewardsLevel, string(headerjson))
+	if err != nil {
+		return fmt.Errorf("snapshot block header, %v", err)
+	}
+
+	setAccount, err := tx.Prepare(`INSERT INTO account (addr, microalgos, rewardsbase, account_data) VALUES ($1, $2, $3, $4)`)
+	if err != nil {
+		return
+	}
+	defer setAccount.Close()
+	setHolding, err := tx.Prepare(`INSERT INTO account_asset (addr, assetid, amount, frozen) VALUES ($1, $2, $3, $4)`)
+	if err != nil {
+		return
+	}
+	defer setHolding.Close()
+	setAsset, err := tx.Prepare(`INSERT INTO asset (index, creator_addr, params, manager_addr, reserve_addr, freeze_addr, clawback_addr) VALUES ($1, $2, $3, $4, $5, $6, $7)`)
+	if err != nil {
+		return
+	}
+	defer setAsset.Close()
+
+	total := uint64(0)
+	holdings := 0
+	assets := 0
+	for ai, alloc := range snapshot.Accounts {
+		addr, err := atypes.DecodeAddress(alloc.Address)
+		if err != nil {
+			return fmt.Errorf("snapshot account[%d] bad address, %v", ai, err)
+		}
+		// account_data is everything except MicroAlgos, RewardsBase, Assets, and AssetParams, which have their own columns and tables
+		ad := alloc.State
+		ad.MicroAlgos = 0
+		ad.RewardsBase = 0
+		ad.Assets = nil
+		ad.AssetParams = nil
+		_, err = setAccount.Exec(addr[:], alloc.State.MicroAlgos, alloc.State.RewardsBase, string(json.Encode(ad)))
+		if err != nil {
+			return fmt.Errorf("error setting snapshot account[%d], %v", ai, err)
+		}
+		total += uint64(alloc.State.MicroAlgos)
+		for assetid, ah := range alloc.State.Assets {
+			_, err = setHolding.Exec(addr[:], uint64(assetid), ah.Amount, ah.Frozen)
+			if err != nil {
+				return fmt.Errorf("error setting snapshot account[%d] asset %d, %v", ai, assetid, err)
+			}
+			holdings++
+		}
+		for assetid, ap := range alloc.State.AssetParams {
+			_, err = setAsset.Exec(append([]interface{}{uint64(assetid), addr[:], string(json.Encode(ap))}, assetRoles(ap)...)...)
+			if err != nil {
+				return fmt.Errorf("error setting snapshot account[%d] params of asset %d, %v", ai, assetid, err)
+			}
+			assets++
+		}
+	}
+
+	istate := ImportState{AccountRound: int64(round)}
+	_, err = tx.Exec(`INSERT INTO metastate (k, v) VALUES ('state', $1) ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v`, string(json.Encode(istate)))
+	if err != nil {
+		return fmt.Errorf("snapshot state, %v", err)
+	}
+	// there are no transactions from before the snapshot, so it is as if they were pruned
+	err = setEarliestRound(tx, round+1)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	fmt.Printf("snapshot round %d %d accounts %d microalgos %d asset holdings %d assets, err=%v\n", round, len(snapshot.Accounts), total, holdings, assets, err)
+	return err
+}
+
 func (db *PostgresIndexerDb) SetProto(version string, proto types.ConsensusParams) (err error) {
 	pj := json.Encode(proto)
 	if err != nil {
@@ -395,6 +641,10 @@ func obs(x interface{}) string {
 	return string(json.Encode(x))
 }
 
+// addRewardedQuery adds $1 to AccountData.RewardedMicroAlgos, which is
+// account_data "ern", of account $2.
+const addRewardedQuery = `UPDATE account SET account_data = jsonb_set(coalesce(account_data, '{}'::jsonb), '{ern}', to_jsonb(coalesce((account_data ->> 'ern')::bigint, 0) + $1)) WHERE addr = $2`
+
 func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round, rewardsBase uint64) (err error) {
 	any := false
 	tx, err := db.db.Begin()
@@ -403,19 +653,78 @@ func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round,
 	}
 	defer tx.Rollback() // ignored if .Commit() first
 
+	// accounts opened or reopened this round, for stats
+	var newAccounts uint64
 	if len(updates.AlgoUpdates) > 0 {
 		any = true
 		// account_data json is only used on account creation, otherwise the account data jsonb field is updated from the delta
-		setalgo, err := tx.Prepare(`INSERT INTO account (addr, microalgos, rewardsbase) VALUES ($1, $2, $3) ON CONFLICT (addr) DO UPDATE SET microalgos = account.microalgos + EXCLUDED.microalgos, rewardsbase = EXCLUDED.rewardsbase`)
+		// a closed account that gets algos again is reopened, and like in algod an account without algos doesn't exist
+		setalgo, err := tx.Prepare(`INSERT INTO account (addr, microalgos, rewardsbase, created_at, deleted) VALUES ($1, $2, $3, $4, $2 = 0) ON CONFLICT (addr) DO UPDATE SET microalgos = account.microalgos + EXCLUDED.microalgos, rewardsbase = EXCLUDED.rewardsbase,
+created_at = CASE WHEN account.deleted AND account.microalgos + EXCLUDED.microalgos > 0 THEN EXCLUDED.created_at ELSE account.created_at END,
+deleted = account.deleted AND account.microalgos + EXCLUDED.microalgos = 0
+RETURNING coalesce(created_at = $4, false) AND NOT deleted`)
 		if err != nil {
 			return fmt.Errorf("prepare update algo, %v", err)
 		}
 		defer setalgo.Close()
+		// a pay of 0 moves the rewards base of an existing receiver but doesn't make one
+		setbase, err := tx.Prepare(`UPDATE account SET rewardsbase = $2 WHERE addr = $1`)
+		if err != nil {
+			return fmt.Errorf("prepare update rewards base, %v", err)
+		}
+		defer setbase.Close()
 		for addr, delta := range updates.AlgoUpdates {
-			_, err = setalgo.Exec(addr[:], delta, rewardsBase)
+			if delta == 0 {
+				_, err = setbase.Exec(addr[:], rewardsBase)
+				if err != nil {
+					return fmt.Errorf("update rewards base, %v", err)
+				}
+				continue
+			}
+			var opened bool
+			err = setalgo.QueryRow(addr[:], delta, rewardsBase, round).Scan(&opened)
 			if err != nil {
 				return fmt.Errorf("update algo, %v", err)
 			}
+			if opened {
+				newAccounts++
+			}
+		}
+	}
+	if len(updates.AccountCloses) > 0 {
+		any = true
+		// still open if it got algos again later in the round
+		setclose, err := tx.Prepare(`UPDATE account SET closed_at = $1, deleted = (microalgos = 0) WHERE addr = $2`)
+		if err != nil {
+			return fmt.Errorf("prepare close account, %v", err)
+		}
+		defer setclose.Close()
+		for addr := range updates.AccountCloses {
+			_, err = setclose.Exec(round, addr[:])
+			if err != nil {
+				return fmt.Errorf("close account, %v", err)
+			}
+		}
+	}
+	// the rounds active accounts were last active before this one, for stats
+	prevActive := make([]sql.NullInt64, 0, len(updates.ActiveAccounts))
+	if len(updates.ActiveAccounts) > 0 {
+		any = true
+		setactive, err := tx.Prepare(`UPDATE account a SET last_active = $1 FROM account prev WHERE a.addr = $2 AND prev.addr = a.addr RETURNING prev.last_active`)
+		if err != nil {
+			return fmt.Errorf("prepare account last active, %v", err)
+		}
+		defer setactive.Close()
+		for addr := range updates.ActiveAccounts {
+			var prev sql.NullInt64
+			err = setactive.QueryRow(round, addr[:]).Scan(&prev)
+			if err == sql.ErrNoRows {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("update account last active, %v", err)
+			}
+			prevActive = append(prevActive, prev)
 		}
 	}
 	if len(updates.AccountTypes) > 0 {
@@ -432,6 +741,53 @@ func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round,
 			}
 		}
 	}
+	if len(updates.LsigPrograms) > 0 {
+		any = true
+		setlsig, err := tx.Prepare(`UPDATE account SET lsigprogram = $1 WHERE addr = $2`)
+		if err != nil {
+			return fmt.Errorf("prepare update lsig program, %v", err)
+		}
+		defer setlsig.Close()
+		for addr, program := range updates.LsigPrograms {
+			_, err = setlsig.Exec(program, addr[:])
+			if err != nil {
+				return fmt.Errorf("update lsig program, %v", err)
+			}
+		}
+	}
+	if len(updates.AuthUpdates) > 0 {
+		any = true
+		// oldauth is the newauth of the previous rekey, kept in the same table
+		addauth, err := tx.Prepare(`INSERT INTO account_auth (addr, round, intra, txid, oldauth, newauth) VALUES ($1, $2, $3, $4, (SELECT h.newauth FROM account_auth h WHERE h.addr = $1 AND (h.round, h.intra) < ($2, $3) ORDER BY h.round DESC, h.intra DESC LIMIT 1), $5) ON CONFLICT DO NOTHING`)
+		if err != nil {
+			return fmt.Errorf("prepare auth history, %v", err)
+		}
+		defer addauth.Close()
+		for _, au := range updates.AuthUpdates {
+			var newauth []byte
+			if au.AuthAddr != au.Addr {
+				newauth = au.AuthAddr[:]
+			}
+			_, err = addauth.Exec(au.Addr[:], au.Round, au.Intra, []byte(au.Txid), newauth)
+			if err != nil {
+				return fmt.Errorf("insert auth history, %v", err)
+			}
+		}
+	}
+	if len(updates.KeyregUpdates) > 0 {
+		any = true
+		addkeyreg, err := tx.Prepare(`INSERT INTO account_keyreg (addr, round, intra, txid, status, votekey, selkey, votefirst, votelast, votekd) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT DO NOTHING`)
+		if err != nil {
+			return fmt.Errorf("prepare keyreg history, %v", err)
+		}
+		defer addkeyreg.Close()
+		for _, kr := range updates.KeyregUpdates {
+			_, err = addkeyreg.Exec(keyregHistoryRow(kr)...)
+			if err != nil {
+				return fmt.Errorf("insert keyreg history, %v", err)
+			}
+		}
+	}
 	if len(updates.AccountDataUpdates) > 0 {
 		any = true
 		setkeyreg, err := tx.Prepare(`UPDATE account SET account_data = coalesce(account_data, '{}'::jsonb) || ($1)::jsonb WHERE addr = $2`)
@@ -447,9 +803,24 @@ func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round,
 			}
 		}
 	}
+	if len(updates.RewardsUpdates) > 0 {
+		any = true
+		setrewards, err := tx.Prepare(addRewardedQuery)
+		if err != nil {
+			return fmt.Errorf("prepare update rewards, %v", err)
+		}
+		defer setrewards.Close()
+		for addr, rewards := range updates.RewardsUpdates {
+			_, err = setrewards.Exec(rewards, addr[:])
+			if err != nil {
+				return fmt.Errorf("update rewards, %v", err)
+			}
+		}
+	}
 	if len(updates.AcfgUpdates) > 0 {
 		any = true
-		setacfg, err := tx.Prepare(`INSERT INTO asset (index, creator_addr, params) VALUES ($1, $2, $3) ON CONFLICT (index) DO UPDATE SET params = EXCLUDED.params`)
+		setacfg, err := tx.Prepare(`INSERT INTO asset (index, creator_addr, params, created_at, manager_addr, reserve_addr, freeze_addr, clawback_addr) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (index) DO UPDATE SET params = (asset.params - 'm' - 'r' - 'f' - 'c') || EXCLUDED.params,
+manager_addr = EXCLUDED.manager_addr, reserve_addr = EXCLUDED.reserve_addr, freeze_addr = EXCLUDED.freeze_addr, clawback_addr = EXCLUDED.clawback_addr`)
 		if err != nil {
 			return fmt.Errorf("prepare set asset, %v", err)
 		}
@@ -458,12 +829,26 @@ func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round,
 			if au.AssetId == debugAsset {
 				fmt.Fprintf(os.Stderr, "%d acfg %s %s\n", round, b64(au.Creator[:]), obs(au))
 			}
-			_, err = setacfg.Exec(au.AssetId, au.Creator[:], string(json.Encode(au.Params)))
+			_, err = setacfg.Exec(append([]interface{}{au.AssetId, au.Creator[:], string(json.Encode(au.Params)), round}, assetRoles(au.Params)...)...)
 			if err != nil {
 				return fmt.Errorf("update asset, %v", err)
 			}
 		}
 	}
+	if len(updates.AssetConfigUpdates) > 0 {
+		any = true
+		addconfig, err := tx.Prepare(addAssetConfigQuery)
+		if err != nil {
+			return fmt.Errorf("prepare asset config history, %v", err)
+		}
+		defer addconfig.Close()
+		for _, ac := range updates.AssetConfigUpdates {
+			_, err = addconfig.Exec(assetConfigHistoryRow(ac)...)
+			if err != nil {
+				return fmt.Errorf("asset config history, %v", err)
+			}
+		}
+	}
 	if len(updates.TxnAssetUpdates) > 0 {
 		any = true
 		uta, err := tx.Prepare(`UPDATE txn SET asset = $1 WHERE round = $2 AND intra = $3`)
@@ -480,7 +865,11 @@ func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round,
 	}
 	if len(updates.AssetUpdates) > 0 {
 		any = true
-		seta, err := tx.Prepare(`INSERT INTO account_asset (addr, assetid, amount, frozen) VALUES ($1, $2, $3, $4) ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUDED.amount`)
+		// opting in again after closing out starts over with the default frozen state
+		seta, err := tx.Prepare(`INSERT INTO account_asset (addr, assetid, amount, frozen, created_at) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUDED.amount,
+frozen = CASE WHEN account_asset.deleted THEN EXCLUDED.frozen ELSE account_asset.frozen END,
+created_at = CASE WHEN account_asset.deleted THEN EXCLUDED.created_at ELSE account_asset.created_at END,
+deleted = false`)
 		if err != nil {
 			return fmt.Errorf("prepare set account_asset, %v", err)
 		}
@@ -494,7 +883,7 @@ func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round,
 					// easy case
 					delta := au.Delta.Int64()
 					// don't skip delta == 0; mark opt-in
-					_, err = seta.Exec(addr[:], au.AssetId, delta, au.DefaultFrozen)
+					_, err = seta.Exec(addr[:], au.AssetId, delta, au.DefaultFrozen, round)
 					if err != nil {
 						return fmt.Errorf("update account asset, %v", err)
 					}
@@ -512,7 +901,7 @@ func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round,
 						continue
 					}
 					for !au.Delta.IsInt64() {
-						_, err = seta.Exec(addr[:], au.AssetId, step, au.DefaultFrozen)
+						_, err = seta.Exec(addr[:], au.AssetId, step, au.DefaultFrozen, round)
 						if err != nil {
 							return fmt.Errorf("update account asset, %v", err)
 						}
@@ -520,7 +909,7 @@ func (db *PostgresIndexerDb) CommitRoundAccounting(updates RoundUpdates, round,
 					}
 					sign = au.Delta.Sign()
 					if sign != 0 {
-						_, err = seta.Exec(addr[:], au.AssetId, au.Delta.Int64(), au.DefaultFrozen)
+						_, err = seta.Exec(addr[:], au.AssetId, au.Delta.Int64(), au.DefaultFrozen, round)
 						if err != nil {
 							return fmt.Errorf("update account asset, %v", err)
 						}
@@ -561,7 +950,8 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 			return fmt.Errorf("prepare asset close1, %v", err)
 		}
 		defer acs.Close()
-		acd, err := tx.Prepare(`DELETE FROM account_asset WHERE addr = $1 AND assetid = $2`)
+		// keep the closed out holding, see AccountQueryOptions.IncludeClosed
+		acd, err := tx.Prepare(`UPDATE account_asset SET amount = 0, closed_at = $3, deleted = true WHERE addr = $1 AND assetid = $2`)
 		if err != nil {
 			return fmt.Errorf("prepare asset close2, %v", err)
 		}
@@ -578,7 +968,7 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 			if err != nil {
 				return fmt.Errorf("asset close send, %v", err)
 			}
-			_, err = acd.Exec(ac.Sender[:], ac.AssetId)
+			_, err = acd.Exec(ac.Sender[:], ac.AssetId, round)
 			if err != nil {
 				return fmt.Errorf("asset close del, %v", err)
 			}
@@ -586,20 +976,36 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 	}
 	if len(updates.AssetDestroys) > 0 {
 		any = true
-		// Note! leaves `asset` row present for historical reference, but deletes all holdings from all accounts
-		ads, err := tx.Prepare(`DELETE FROM account_asset WHERE assetid = $1`)
+		// Note! leaves `asset` row present with its last params for historical reference, and closes out all holdings from all accounts
+		ads, err := tx.Prepare(`UPDATE asset SET destroyed_at = $2, deleted = true WHERE index = $1`)
 		if err != nil {
 			return fmt.Errorf("prepare asset destroy, %v", err)
 		}
 		defer ads.Close()
+		adh, err := tx.Prepare(`UPDATE account_asset SET amount = 0, closed_at = $2, deleted = true WHERE assetid = $1 AND NOT deleted`)
+		if err != nil {
+			return fmt.Errorf("prepare asset destroy holdings, %v", err)
+		}
+		defer adh.Close()
 		for _, assetId := range updates.AssetDestroys {
 			if assetId == debugAsset {
 				fmt.Fprintf(os.Stderr, "%d destroy asset %d\n", round, assetId)
 			}
-			ads.Exec(assetId)
+			_, err = ads.Exec(assetId, round)
 			if err != nil {
 				return fmt.Errorf("asset destroy, %v", err)
 			}
+			_, err = adh.Exec(assetId, round)
+			if err != nil {
+				return fmt.Errorf("asset destroy holdings, %v", err)
+			}
+		}
+	}
+	if len(updates.Stats.TxnCounts) > 0 {
+		any = true
+		err = commitRoundStats(tx, round, updates.Stats, newAccounts, prevActive)
+		if err != nil {
+			return err
 		}
 	}
 	if !any {
@@ -628,6 +1034,117 @@ ON CONFLICT (addr, assetid) DO UPDATE SET amount = account_asset.amount + EXCLUD
 	return tx.Commit()
 }
 
+// Delete pruned transactions a few rounds at a time so that no single
+// PostgreSQL transaction gets too big.
+const pruneBatchRounds = 1000
+
+func (db *PostgresIndexerDb) PruneTransactions(policy RetentionPolicy) (earliestRound uint64, err error) {
+	retentionJsonStr, err := db.GetMetastate("retention")
+	if err != nil {
+		return 0, fmt.Errorf("getting retention state, %v", err)
+	}
+	var retention RetentionState
+	if retentionJsonStr != "" {
+		retention, err = ParseRetentionState(retentionJsonStr)
+		if err != nil {
+			return 0, fmt.Errorf("parsing retention state, %v", err)
+		}
+	}
+	earliestRound = retention.EarliestRound
+	if policy.Rounds == 0 && policy.Age == 0 {
+		return
+	}
+
+	stateJsonStr, err := db.GetMetastate("state")
+	if err != nil || stateJsonStr == "" {
+		return
+	}
+	state, err := ParseImportState(stateJsonStr)
+	if err != nil {
+		return earliestRound, fmt.Errorf("parsing import state, %v", err)
+	}
+	if state.AccountRound < 0 {
+		return
+	}
+	accountRound := uint64(state.AccountRound)
+
+	cutoff := uint64(0)
+	if policy.Rounds != 0 && accountRound+1 > policy.Rounds {
+		cutoff = accountRound + 1 - policy.Rounds
+	}
+	if policy.Age != 0 {
+		var ageCutoff sql.NullInt64
+		row := db.db.QueryRow(`SELECT min(round) FROM block_header WHERE realtime >= $1`, time.Now().Add(-policy.Age))
+		err = row.Scan(&ageCutoff)
+		if err != nil {
+			return earliestRound, fmt.Errorf("finding round by age, %v", err)
+		}
+		if !ageCutoff.Valid {
+			// every block is too old
+			cutoff = accountRound
+		} else if uint64(ageCutoff.Int64) > cutoff {
+			cutoff = uint64(ageCutoff.Int64)
+		}
+	}
+	// Transactions not yet applied to account state are still needed by accounting.
+	if cutoff > accountRound {
+		cutoff = accountRound
+	}
+	if cutoff <= earliestRound {
+		return
+	}
+
+	for earliestRound < cutoff {
+		end := earliestRound + pruneBatchRounds
+		if end > cutoff {
+			end = cutoff
+		}
+		err = db.pruneBefore(end)
+		if err != nil {
+			return
+		}
+		earliestRound = end
+	}
+	return
+}
+
+// pruneBefore deletes transactions before round and records round as the earliest available.
+func (db *PostgresIndexerDb) pruneBefore(round uint64) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return
+	}
+	defer tx.Rollback() // ignored if .Commit() first
+	_, err = tx.Exec(`DELETE FROM txn_participation WHERE round < $1`, round)
+	if err != nil {
+		return fmt.Errorf("pruning txn_participation before %d, %v", round, err)
+	}
+	_, err = tx.Exec(`DELETE FROM txn_msig WHERE round < $1`, round)
+	if err != nil {
+		return fmt.Errorf("pruning txn_msig before %d, %v", round, err)
+	}
+	_, err = tx.Exec(`DELETE FROM txn WHERE round < $1`, round)
+	if err != nil {
+		return fmt.Errorf("pruning txn before %d, %v", round, err)
+	}
+	err = setEarliestRound(tx, round)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// setEarliestRound records round as the earliest with transactions,
+// unless a later one is already recorded.
+func setEarliestRound(tx *sql.Tx, round uint64) error {
+	retention := RetentionState{EarliestRound: round}
+	_, err := tx.Exec(`INSERT INTO metastate (k, v) VALUES ('retention', $1) ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v WHERE (metastate.v ->> 'earliest_round')::bigint < (EXCLUDED.v ->> 'earliest_round')::bigint`, string(json.Encode(retention)))
+	if err != nil {
+		return fmt.Errorf("saving retention state, %v", err)
+	}
+	return nil
+}
+
 func (db *PostgresIndexerDb) GetBlock(round uint64) (block types.Block, err error) {
 	row := db.db.QueryRow(`SELECT header FROM block_header WHERE round = $1`, round)
 	var blockheaderjson []byte
@@ -639,6 +1156,20 @@ func (db *PostgresIndexerDb) GetBlock(round uint64) (block types.Block, err erro
 	return
 }
 
+// prefixEnd returns the smallest byte string greater than every string
+// starting with prefix, or nil if there is none (prefix is all 0xff).
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] != 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
 func buildTransactionQuery(tf TransactionFilter) (query string, whereArgs []interface{}) {
 	// TODO? There are some combinations of tf params that will
 	// yield no results and we could catch that before asking the
@@ -653,45 +1184,9 @@ func buildTransactionQuery(tf TransactionFilter) (query string, whereArgs []inte
 		whereArgs = append(whereArgs, tf.Address)
 		partNumber++
 		if tf.AddressRole != 0 {
-			addrBase64 := base64.StdEncoding.EncodeToString(tf.Address)
-			roleparts := make([]string, 0, 8)
-			if tf.AddressRole&AddressRoleSender != 0 {
-				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' ->> 'snd' = $%d", partNumber))
-				whereArgs = append(whereArgs, addrBase64)
-				partNumber++
-			}
-			if tf.AddressRole&AddressRoleReceiver != 0 {
-				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' ->> 'rcv' = $%d", partNumber))
-				whereArgs = append(whereArgs, addrBase64)
-				partNumber++
-			}
-			if tf.AddressRole&AddressRoleCloseRemainderTo != 0 {
-				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' ->> 'close' = $%d", partNumber))
-				whereArgs = append(whereArgs, addrBase64)
-				partNumber++
-			}
-			if tf.AddressRole&AddressRoleAssetSender != 0 {
-				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' ->> 'asnd' = $%d", partNumber))
-				whereArgs = append(whereArgs, addrBase64)
-				partNumber++
-			}
-			if tf.AddressRole&AddressRoleAssetReceiver != 0 {
-				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' ->> 'arcv' = $%d", partNumber))
-				whereArgs = append(whereArgs, addrBase64)
-				partNumber++
-			}
-			if tf.AddressRole&AddressRoleAssetCloseTo != 0 {
-				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' ->> 'aclose' = $%d", partNumber))
-				whereArgs = append(whereArgs, addrBase64)
-				partNumber++
-			}
-			if tf.AddressRole&AddressRoleFreeze != 0 {
-				roleparts = append(roleparts, fmt.Sprintf("t.txn -> 'txn' ->> 'afrz' = $%d", partNumber))
-				whereArgs = append(whereArgs, addrBase64)
-				partNumber++
-			}
-			rolepart := strings.Join(roleparts, " OR ")
-			whereParts = append(whereParts, "("+rolepart+")")
+			whereParts = append(whereParts, fmt.Sprintf("(p.role & $%d) != 0", partNumber))
+			whereArgs = append(whereArgs, tf.AddressRole)
+			partNumber++
 		}
 		joinParticipation = true
 	}
@@ -721,12 +1216,12 @@ func buildTransactionQuery(tf TransactionFilter) (query string, whereArgs []inte
 		partNumber++
 	}
 	if tf.AssetAmountGT != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("(t.txn -> 'txn' -> 'aamt')::bigint > $%d", partNumber))
+		whereParts = append(whereParts, fmt.Sprintf("t.assetamount > $%d", partNumber))
 		whereArgs = append(whereArgs, tf.AssetAmountGT)
 		partNumber++
 	}
 	if tf.AssetAmountLT != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("(t.txn -> 'txn' -> 'aamt')::bigint < $%d", partNumber))
+		whereParts = append(whereParts, fmt.Sprintf("t.assetamount < $%d", partNumber))
 		whereArgs = append(whereArgs, tf.AssetAmountLT)
 		partNumber++
 	}
@@ -761,37 +1256,58 @@ func buildTransactionQuery(tf TransactionFilter) (query string, whereArgs []inte
 		partNumber++
 	}
 	if len(tf.SigType) != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("t.txn -> $%d IS NOT NULL", partNumber))
+		whereParts = append(whereParts, fmt.Sprintf("t.sigtype = $%d", partNumber))
 		whereArgs = append(whereArgs, tf.SigType)
 		partNumber++
 	}
 	if len(tf.NotePrefix) > 0 {
-		whereParts = append(whereParts, fmt.Sprintf("substring(decode(t.txn -> 'txn' ->> 'note', 'base64') from 1 for %d) = $%d", len(tf.NotePrefix), partNumber))
+		// a range can use the txn_note index where substring() can't
+		whereParts = append(whereParts, fmt.Sprintf("t.note >= $%d", partNumber))
 		whereArgs = append(whereArgs, tf.NotePrefix)
 		partNumber++
+		if end := prefixEnd(tf.NotePrefix); end != nil {
+			whereParts = append(whereParts, fmt.Sprintf("t.note < $%d", partNumber))
+			whereArgs = append(whereArgs, end)
+			partNumber++
+		}
 	}
 	if tf.AlgosGT != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("(t.txn -> 'txn' -> 'amt')::bigint > $%d", partNumber))
+		whereParts = append(whereParts, fmt.Sprintf("t.amount > $%d", partNumber))
 		whereArgs = append(whereArgs, tf.AlgosGT)
 		partNumber++
 	}
 	if tf.AlgosLT != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("(t.txn -> 'txn' -> 'amt')::bigint < $%d", partNumber))
+		whereParts = append(whereParts, fmt.Sprintf("t.amount < $%d", partNumber))
 		whereArgs = append(whereArgs, tf.AlgosLT)
 		partNumber++
 	}
 	if tf.EffectiveAmountGt != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("((t.txn -> 'ca')::bigint + (t.txn -> 'txn' -> 'amt')::bigint) > $%d", partNumber))
+		whereParts = append(whereParts, fmt.Sprintf("(t.amount + coalesce(t.closeamount, 0)) > $%d", partNumber))
 		whereArgs = append(whereArgs, tf.EffectiveAmountGt)
 		partNumber++
 	}
 	if tf.EffectiveAmountLt != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("((t.txn -> 'ca')::bigint + (t.txn -> 'txn' -> 'amt')::bigint) < $%d", partNumber))
+		whereParts = append(whereParts, fmt.Sprintf("(t.amount + coalesce(t.closeamount, 0)) < $%d", partNumber))
 		whereArgs = append(whereArgs, tf.EffectiveAmountLt)
 		partNumber++
 	}
+	if len(tf.GroupId) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("t.txgroup = $%d", partNumber))
+		whereArgs = append(whereArgs, tf.GroupId)
+		partNumber++
+	}
+	if len(tf.LsigProgramHash) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("t.lsighash = $%d", partNumber))
+		whereArgs = append(whereArgs, tf.LsigProgramHash)
+		partNumber++
+	}
+	if len(tf.MsigParticipant) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("(t.round, t.intra) IN (SELECT m.round, m.intra FROM txn_msig m WHERE m.pubkey = $%d)", partNumber))
+		whereArgs = append(whereArgs, tf.MsigParticipant)
+		partNumber++
+	}
 	if tf.RekeyTo != nil && (*tf.RekeyTo) {
-		whereParts = append(whereParts, fmt.Sprintf("(t.txn -> 'txn' -> 'rekey') IS NOT NULL"))
+		whereParts = append(whereParts, "t.rekeyto IS NOT NULL")
 	}
 	query = "SELECT t.round, t.intra, t.txnbytes, t.extra, t.asset, h.realtime FROM txn t JOIN block_header h ON t.round = h.round"
 	if joinParticipation {
@@ -801,9 +1317,16 @@ func buildTransactionQuery(tf TransactionFilter) (query string, whereArgs []inte
 		whereStr := strings.Join(whereParts, " AND ")
 		query += " WHERE " + whereStr
 	}
-	if joinParticipation {
+	desc := tf.Descending()
+	if joinParticipation && desc {
 		// this should match the index on txn_particpation
 		query += " ORDER BY p.addr, p.round DESC, p.intra DESC"
+	} else if joinParticipation {
+		// the same index read backwards
+		query += " ORDER BY p.addr, p.round, p.intra"
+	} else if desc {
+		// the primary key on txn (round,intra) read backwards
+		query += " ORDER BY t.round DESC, t.intra DESC"
 	} else {
 		// this should explicitly match the primary key on txn (round,intra)
 		query += " ORDER BY t.round, t.intra"
@@ -834,16 +1357,25 @@ func (db *PostgresIndexerDb) Transactions(ctx context.Context, tf TransactionFil
 }
 
 func (db *PostgresIndexerDb) txnsWithNext(ctx context.Context, tf TransactionFilter, out chan<- TxnRow) {
-	nextround, nextintra32, err := DecodeTxnRowNext(tf.NextToken)
+	nextround, nextintra32, order, err := DecodeTxnRowNext(tf.NextToken)
 	nextintra := uint64(nextintra32)
 	if err != nil {
 		out <- TxnRow{Error: err}
 		close(out)
+		return
+	}
+	if tf.Order == TxnOrderDefault {
+		tf.Order = order
+	} else if order != tf.Order {
+		out <- TxnRow{Error: fmt.Errorf("next token order does not match requested order")}
+		close(out)
+		return
 	}
+	desc := tf.Descending()
 	origRound := tf.Round
 	origOLT := tf.OffsetLT
 	origOGT := tf.OffsetGT
-	if tf.Address != nil {
+	if desc {
 		// (round,intra) descending into the past
 		if nextround == 0 && nextintra == 0 {
 			close(out)
@@ -882,7 +1414,7 @@ func (db *PostgresIndexerDb) txnsWithNext(ctx context.Context, tf TransactionFil
 	default:
 	}
 	tf.Round = origRound
-	if tf.Address != nil {
+	if desc {
 		// (round,intra) descending into the past
 		tf.OffsetLT = origOLT
 		if nextround == 0 {
@@ -954,51 +1486,65 @@ finish:
 
 const maxAccountsLimit = 1000
 
-var statusStrings = []string{"Offline", "Online", "NotParticipating"}
-
 const offlineStatusIdx = 0
 
 func (db *PostgresIndexerDb) yieldAccountsThread(ctx context.Context, opts AccountQueryOptions, rows *sql.Rows, tx *sql.Tx, blockheader types.Block, out chan<- AccountRow) {
 	defer tx.Rollback()
-	count := uint64(0)
-	for rows.Next() {
+	// TODO: pending rewards calculation doesn't belong in database layer (this is just the most covenient place which has all the data)
+	proto, err := db.GetProto(string(blockheader.CurrentProtocol))
+	if err != nil {
+		out <- AccountRow{Error: fmt.Errorf("account round proto %s, %v", blockheader.CurrentProtocol, err)}
+		close(out)
+		return
+	}
+	count := uint64(0)
+	for rows.Next() {
 		var addr []byte
 		var microalgos uint64
 		var rewardsbase uint64
 		var keytype *string
 		var accountDataJsonStr []byte
+		var lsigProgram []byte
+		var createdAt, closedAt, lastActive sql.NullInt64
+		var deleted bool
 
 		// these are bytes of json serialization
 		var holdingAssetid []byte
 		var holdingAmount []byte
 		var holdingFrozen []byte
+		var holdingCreated []byte
+		var holdingClosed []byte
+		var holdingDeleted []byte
 
 		// these are bytes of json serialization
 		var assetParamsIds []byte
 		var assetParamsStr []byte
+		var assetParamsCreated []byte
+		var assetParamsDestroyed []byte
+		var assetParamsDeleted []byte
 
 		var err error
 
 		if opts.IncludeAssetHoldings {
 			if opts.IncludeAssetParams {
 				err = rows.Scan(
-					&addr, &microalgos, &rewardsbase, &keytype, &accountDataJsonStr,
-					&holdingAssetid, &holdingAmount, &holdingFrozen,
-					&assetParamsIds, &assetParamsStr,
+					&addr, &microalgos, &rewardsbase, &keytype, &accountDataJsonStr, &lsigProgram, &createdAt, &closedAt, &lastActive, &deleted,
+					&holdingAssetid, &holdingAmount, &holdingFrozen, &holdingCreated, &holdingClosed, &holdingDeleted,
+					&assetParamsIds, &assetParamsStr, &assetParamsCreated, &assetParamsDestroyed, &assetParamsDeleted,
 				)
 			} else {
 				err = rows.Scan(
-					&addr, &microalgos, &rewardsbase, &keytype, &accountDataJsonStr,
-					&holdingAssetid, &holdingAmount, &holdingFrozen,
+					&addr, &microalgos, &rewardsbase, &keytype, &accountDataJsonStr, &lsigProgram, &createdAt, &closedAt, &lastActive, &deleted,
+					&holdingAssetid, &holdingAmount, &holdingFrozen, &holdingCreated, &holdingClosed, &holdingDeleted,
 				)
 			}
 		} else if opts.IncludeAssetParams {
 			err = rows.Scan(
-				&addr, &microalgos, &rewardsbase, &keytype, &accountDataJsonStr,
-				&assetParamsIds, &assetParamsStr,
+				&addr, &microalgos, &rewardsbase, &keytype, &accountDataJsonStr, &lsigProgram, &createdAt, &closedAt, &lastActive, &deleted,
+				&assetParamsIds, &assetParamsStr, &assetParamsCreated, &assetParamsDestroyed, &assetParamsDeleted,
 			)
 		} else {
-			err = rows.Scan(&addr, &microalgos, &rewardsbase, &keytype, &accountDataJsonStr)
+			err = rows.Scan(&addr, &microalgos, &rewardsbase, &keytype, &accountDataJsonStr, &lsigProgram, &createdAt, &closedAt, &lastActive, &deleted)
 		}
 		if err != nil {
 			out <- AccountRow{Error: err}
@@ -1014,10 +1560,19 @@ func (db *PostgresIndexerDb) yieldAccountsThread(ctx context.Context, opts Accou
 		account.RewardBase = new(uint64)
 		*account.RewardBase = rewardsbase
 		// default to Offline in there have been no keyreg transactions.
-		account.Status = statusStrings[offlineStatusIdx]
+		account.Status = StatusStrings[offlineStatusIdx]
+		status := offlineStatusIdx
+		var rewarded uint64
 		if keytype != nil && *keytype != "" {
 			account.SigType = keytype
 		}
+		if len(lsigProgram) > 0 {
+			account.LsigProgram = &lsigProgram
+		}
+		account.CreatedAtRound = nullUint64Ptr(createdAt)
+		account.ClosedAtRound = nullUint64Ptr(closedAt)
+		account.LastActiveRound = nullUint64Ptr(lastActive)
+		account.Deleted = boolPtr(deleted)
 
 		if accountDataJsonStr != nil {
 			var ad types.AccountData
@@ -1026,7 +1581,9 @@ func (db *PostgresIndexerDb) yieldAccountsThread(ctx context.Context, opts Accou
 				out <- AccountRow{Error: err}
 				break
 			}
-			account.Status = statusStrings[ad.Status]
+			account.Status = StatusStrings[ad.Status]
+			status = int(ad.Status)
+			rewarded = uint64(ad.RewardedMicroAlgos)
 			hasSel := !allZero(ad.SelectionID[:])
 			hasVote := !allZero(ad.VoteID[:])
 			if hasSel || hasVote {
@@ -1044,19 +1601,11 @@ func (db *PostgresIndexerDb) yieldAccountsThread(ctx context.Context, opts Accou
 			}
 		}
 
-		// TODO: pending rewards calculation doesn't belong in database layer (this is just the most covenient place which has all the data)
-		proto, err := db.GetProto(string(blockheader.CurrentProtocol))
-		rewardsUnits := uint64(0)
-		if proto.RewardUnit != 0 {
-			rewardsUnits = microalgos / proto.RewardUnit
-		}
-		rewardsDelta := blockheader.RewardsLevel - rewardsbase
-		account.PendingRewards = rewardsUnits * rewardsDelta
+		account.PendingRewards = PendingRewards(proto, status, microalgos, rewardsbase, blockheader.RewardsLevel)
 		account.Amount = microalgos + account.PendingRewards
-		// not implemented: account.Rewards sum of all rewards ever
+		account.Rewards = rewarded + account.PendingRewards
 
 		const nullarraystr = "[null]"
-		reject := opts.HasAssetId != 0
 
 		if len(holdingAssetid) > 0 && string(holdingAssetid) != nullarraystr {
 			var haids []uint64
@@ -1077,6 +1626,24 @@ func (db *PostgresIndexerDb) yieldAccountsThread(ctx context.Context, opts Accou
 				out <- AccountRow{Error: err}
 				break
 			}
+			var hcreated []*uint64
+			err = json.Decode(holdingCreated, &hcreated)
+			if err != nil {
+				out <- AccountRow{Error: err}
+				break
+			}
+			var hclosed []*uint64
+			err = json.Decode(holdingClosed, &hclosed)
+			if err != nil {
+				out <- AccountRow{Error: err}
+				break
+			}
+			var hdeleted []bool
+			err = json.Decode(holdingDeleted, &hdeleted)
+			if err != nil {
+				out <- AccountRow{Error: err}
+				break
+			}
 			av := make([]models.AssetHolding, 0, len(haids))
 			for i, assetid := range haids {
 				// SQL can result in cross-product duplication when account has bothe asset holdings and assets created, de-dup here
@@ -1090,28 +1657,19 @@ func (db *PostgresIndexerDb) yieldAccountsThread(ctx context.Context, opts Accou
 				if dup {
 					continue
 				}
-				if assetid == opts.HasAssetId {
-					if opts.AssetGT != 0 {
-						if hamounts[i] > opts.AssetGT {
-							reject = false
-						}
-					} else if opts.AssetLT != 0 {
-						if hamounts[i] < opts.AssetLT {
-							reject = false
-						}
-					} else {
-						reject = false
-					}
-				}
-				tah := models.AssetHolding{Amount: hamounts[i], IsFrozen: hfrozen[i], AssetId: assetid} // TODO: set Creator to asset creator addr string
+				tah := models.AssetHolding{
+					Amount:          hamounts[i],
+					IsFrozen:        hfrozen[i],
+					AssetId:         assetid,
+					OptedInAtRound:  hcreated[i],
+					OptedOutAtRound: hclosed[i],
+					Deleted:         boolPtr(hdeleted[i]),
+				} // TODO: set Creator to asset creator addr string
 				av = append(av, tah)
 			}
 			account.Assets = new([]models.AssetHolding)
 			*account.Assets = av
 		}
-		if reject {
-			continue
-		}
 		if len(assetParamsIds) > 0 && string(assetParamsIds) != nullarraystr {
 			var assetids []uint64
 			err = json.Decode(assetParamsIds, &assetids)
@@ -1125,6 +1683,24 @@ func (db *PostgresIndexerDb) yieldAccountsThread(ctx context.Context, opts Accou
 				out <- AccountRow{Error: err}
 				break
 			}
+			var pcreated []*uint64
+			err = json.Decode(assetParamsCreated, &pcreated)
+			if err != nil {
+				out <- AccountRow{Error: err}
+				break
+			}
+			var pdestroyed []*uint64
+			err = json.Decode(assetParamsDestroyed, &pdestroyed)
+			if err != nil {
+				out <- AccountRow{Error: err}
+				break
+			}
+			var pdeleted []bool
+			err = json.Decode(assetParamsDeleted, &pdeleted)
+			if err != nil {
+				out <- AccountRow{Error: err}
+				break
+			}
 			cal := make([]models.Asset, 0, len(assetids))
 			for i, assetid := range assetids {
 				// SQL can result in cross-product duplication when account has bothe asset holdings and assets created, de-dup here
@@ -1155,6 +1731,9 @@ func (db *PostgresIndexerDb) yieldAccountsThread(ctx context.Context, opts Accou
 						Freeze:        addrStr(ap.Freeze[:]),
 						Clawback:      addrStr(ap.Clawback[:]),
 					},
+					CreatedAtRound:   pcreated[i],
+					DestroyedAtRound: pdestroyed[i],
+					Deleted:          boolPtr(pdeleted[i]),
 				}
 				cal = append(cal, tma)
 			}
@@ -1188,6 +1767,15 @@ func stringPtr(x string) *string {
 	return out
 }
 
+func nullUint64Ptr(x sql.NullInt64) *uint64 {
+	if !x.Valid {
+		return nil
+	}
+	out := new(uint64)
+	*out = uint64(x.Int64)
+	return out
+}
+
 func baPtr(x []byte) *[]byte {
 	out := new([]byte)
 	*out = x
@@ -1222,9 +1810,7 @@ func addrStr(addr []byte) *string {
 func (db *PostgresIndexerDb) GetAccounts(ctx context.Context, opts AccountQueryOptions) <-chan AccountRow {
 	out := make(chan AccountRow, 1)
 
-	if opts.HasAssetId != 0 {
-		opts.IncludeAssetHoldings = true
-	} else if (opts.AssetGT != 0) || (opts.AssetLT != 0) {
+	if opts.HasAssetId == 0 && ((opts.AssetGT != 0) || (opts.AssetLT != 0)) {
 		err := fmt.Errorf("AssetGT=%d, AssetLT=%d, but HasAssetId=%d", opts.AssetGT, opts.AssetLT, opts.HasAssetId)
 		out <- AccountRow{Error: err}
 		close(out)
@@ -1274,29 +1860,49 @@ func (db *PostgresIndexerDb) GetAccounts(ctx context.Context, opts AccountQueryO
 	}
 
 	// Construct query for fetching accounts...
-	query := `SELECT a.addr, a.microalgos, a.rewardsbase, a.keytype, a.account_data`
+	query := `SELECT a.addr, a.microalgos, a.rewardsbase, a.keytype, a.account_data, a.lsigprogram, a.created_at, a.closed_at, a.last_active, a.deleted`
 	if opts.IncludeAssetHoldings {
-		query += `, json_agg(aa.assetid) as haid, json_agg(aa.amount) as hamt, json_agg(aa.frozen) as hf`
+		query += `, json_agg(aa.assetid) as haid, json_agg(aa.amount) as hamt, json_agg(aa.frozen) as hf, json_agg(aa.created_at) as hca, json_agg(aa.closed_at) as hcl, json_agg(aa.deleted) as hd`
 	}
 	if opts.IncludeAssetParams {
-		query += `, json_agg(ap.index) as paid, json_agg(ap.params) as pp`
+		query += `, json_agg(ap.index) as paid, json_agg(ap.params) as pp, json_agg(ap.created_at) as pca, json_agg(ap.destroyed_at) as pda, json_agg(ap.deleted) as pd`
 	}
 	query += ` FROM account a`
 	if opts.IncludeAssetHoldings {
 		query += ` LEFT JOIN account_asset aa ON a.addr = aa.addr`
+		if !opts.IncludeClosed {
+			query += ` AND NOT aa.deleted`
+		}
 	}
 	if opts.IncludeAssetParams {
 		query += ` LEFT JOIN asset ap ON a.addr = ap.creator_addr`
+		if !opts.IncludeClosed {
+			query += ` AND NOT ap.deleted`
+		}
 	}
 	const maxWhereParts = 14
 	whereParts := make([]string, 0, maxWhereParts)
 	whereArgs := make([]interface{}, 0, maxWhereParts)
 	partNumber := 1
+	if !opts.IncludeClosed {
+		whereParts = append(whereParts, "NOT a.deleted")
+	}
+	if opts.ActiveSince != 0 {
+		// uses account_by_last_active
+		whereParts = append(whereParts, fmt.Sprintf("a.last_active >= $%d", partNumber))
+		whereArgs = append(whereArgs, opts.ActiveSince)
+		partNumber++
+	}
 	if len(opts.GreaterThanAddress) > 0 {
 		whereParts = append(whereParts, fmt.Sprintf("a.addr > $%d", partNumber))
 		whereArgs = append(whereArgs, opts.GreaterThanAddress)
 		partNumber++
 	}
+	if opts.OrderByBalance && len(opts.AddressBefore) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("(a.microalgos, a.addr) < ($%d, $%d)", partNumber, partNumber+1))
+		whereArgs = append(whereArgs, opts.BalanceBefore, opts.AddressBefore)
+		partNumber += 2
+	}
 	if len(opts.EqualToAddress) > 0 {
 		whereParts = append(whereParts, fmt.Sprintf("a.addr = $%d", partNumber))
 		whereArgs = append(whereArgs, opts.EqualToAddress)
@@ -1317,6 +1923,43 @@ func (db *PostgresIndexerDb) GetAccounts(ctx context.Context, opts AccountQueryO
 		whereArgs = append(whereArgs, opts.EqualToAuthAddr)
 		partNumber++
 	}
+	if len(opts.EverAuthAddr) > 0 {
+		whereParts = append(whereParts, fmt.Sprintf("a.addr IN (SELECT h.addr FROM account_auth h WHERE h.newauth = $%d)", partNumber))
+		whereArgs = append(whereArgs, opts.EverAuthAddr)
+		partNumber++
+	}
+	if opts.Status != nil {
+		// status is omitted from account_data when Offline
+		whereParts = append(whereParts, fmt.Sprintf("coalesce((a.account_data ->> 'onl')::int, 0) = $%d", partNumber))
+		whereArgs = append(whereArgs, *opts.Status)
+		partNumber++
+	}
+	if opts.VoteLastBefore != 0 {
+		// uses account_by_votelst
+		whereParts = append(whereParts, fmt.Sprintf("a.account_data ->> 'onl' = '1' AND (a.account_data ->> 'voteLst')::bigint < $%d", partNumber))
+		whereArgs = append(whereArgs, opts.VoteLastBefore)
+		partNumber++
+	}
+	if opts.HasAssetId != 0 {
+		// uses account_asset primary key or account_asset_by_asset_amount_addr depending on how popular the asset is
+		holdingParts := []string{"xa.addr = a.addr", fmt.Sprintf("xa.assetid = $%d", partNumber)}
+		whereArgs = append(whereArgs, opts.HasAssetId)
+		partNumber++
+		if !opts.IncludeClosed {
+			holdingParts = append(holdingParts, "NOT xa.deleted")
+		}
+		if opts.AssetGT != 0 {
+			holdingParts = append(holdingParts, fmt.Sprintf("xa.amount > $%d", partNumber))
+			whereArgs = append(whereArgs, opts.AssetGT)
+			partNumber++
+		}
+		if opts.AssetLT != 0 {
+			holdingParts = append(holdingParts, fmt.Sprintf("xa.amount < $%d", partNumber))
+			whereArgs = append(whereArgs, opts.AssetLT)
+			partNumber++
+		}
+		whereParts = append(whereParts, "EXISTS (SELECT 1 FROM account_asset xa WHERE "+strings.Join(holdingParts, " AND ")+")")
+	}
 	if len(whereParts) > 0 {
 		whereStr := strings.Join(whereParts, " AND ")
 		query += " WHERE " + whereStr
@@ -1324,9 +1967,13 @@ func (db *PostgresIndexerDb) GetAccounts(ctx context.Context, opts AccountQueryO
 	if opts.IncludeAssetHoldings || opts.IncludeAssetParams {
 		query += " GROUP BY 1,2,3,4"
 	}
-	query += " ORDER BY a.addr ASC"
-	if opts.Limit != 0 && opts.HasAssetId == 0 {
-		// sql limit gets disabled when we filter client side
+	if opts.OrderByBalance {
+		// uses account_by_microalgos
+		query += " ORDER BY a.microalgos DESC, a.addr DESC"
+	} else {
+		query += " ORDER BY a.addr ASC"
+	}
+	if opts.Limit != 0 {
 		query += fmt.Sprintf(" LIMIT %d", opts.Limit)
 	}
 	rows, err := tx.Query(query, whereArgs...)
@@ -1342,11 +1989,14 @@ func (db *PostgresIndexerDb) GetAccounts(ctx context.Context, opts AccountQueryO
 }
 
 func (db *PostgresIndexerDb) Assets(ctx context.Context, filter AssetsQuery) <-chan AssetRow {
-	query := `SELECT index, creator_addr, params FROM asset a`
+	query := `SELECT index, creator_addr, params, created_at, destroyed_at, deleted FROM asset a`
 	const maxWhereParts = 14
 	whereParts := make([]string, 0, maxWhereParts)
 	whereArgs := make([]interface{}, 0, maxWhereParts)
 	partNumber := 1
+	if !filter.IncludeDeleted {
+		whereParts = append(whereParts, "NOT a.deleted")
+	}
 	if filter.AssetId != 0 {
 		whereParts = append(whereParts, fmt.Sprintf("a.index = $%d", partNumber))
 		whereArgs = append(whereArgs, filter.AssetId)
@@ -1362,27 +2012,65 @@ func (db *PostgresIndexerDb) Assets(ctx context.Context, filter AssetsQuery) <-c
 		whereArgs = append(whereArgs, filter.Creator)
 		partNumber++
 	}
+	if filter.Manager != nil {
+		whereParts = append(whereParts, fmt.Sprintf("a.manager_addr = $%d", partNumber))
+		whereArgs = append(whereArgs, filter.Manager)
+		partNumber++
+	}
+	if filter.Reserve != nil {
+		whereParts = append(whereParts, fmt.Sprintf("a.reserve_addr = $%d", partNumber))
+		whereArgs = append(whereArgs, filter.Reserve)
+		partNumber++
+	}
+	if filter.Freeze != nil {
+		whereParts = append(whereParts, fmt.Sprintf("a.freeze_addr = $%d", partNumber))
+		whereArgs = append(whereArgs, filter.Freeze)
+		partNumber++
+	}
+	if filter.Clawback != nil {
+		whereParts = append(whereParts, fmt.Sprintf("a.clawback_addr = $%d", partNumber))
+		whereArgs = append(whereArgs, filter.Clawback)
+		partNumber++
+	}
+	// similarity of each text filter for OrderByRelevance, which needs pg_trgm
+	rankParts := make([]string, 0, 5)
+	rank := func(text string, keys ...string) {
+		if !db.trgm {
+			return
+		}
+		for _, key := range keys {
+			rankParts = append(rankParts, fmt.Sprintf("similarity(a.params ->> '%s', $%d)", key, partNumber))
+		}
+		whereArgs = append(whereArgs, text)
+		partNumber++
+	}
 	if filter.Name != "" {
 		whereParts = append(whereParts, fmt.Sprintf("a.params ->> 'an' ILIKE $%d", partNumber))
-		whereArgs = append(whereArgs, "%"+filter.Name+"%")
+		whereArgs = append(whereArgs, assetLikePattern(filter.Match, filter.Name))
 		partNumber++
+		rank(filter.Name, "an")
 	}
 	if filter.Unit != "" {
 		whereParts = append(whereParts, fmt.Sprintf("a.params ->> 'un' ILIKE $%d", partNumber))
-		whereArgs = append(whereArgs, "%"+filter.Unit+"%")
+		whereArgs = append(whereArgs, assetLikePattern(filter.Match, filter.Unit))
 		partNumber++
+		rank(filter.Unit, "un")
 	}
 	if filter.Query != "" {
-		qs := "%" + filter.Query + "%"
-		whereParts = append(whereParts, fmt.Sprintf("(a.params ->> 'un' ILIKE $%d OR a.params ->> 'an' ILIKE $%d)", partNumber, partNumber))
-		whereArgs = append(whereArgs, qs)
+		whereParts = append(whereParts, fmt.Sprintf("(a.params ->> 'un' ILIKE $%d OR a.params ->> 'an' ILIKE $%d OR a.params ->> 'au' ILIKE $%d)", partNumber, partNumber, partNumber))
+		whereArgs = append(whereArgs, assetLikePattern(filter.Match, filter.Query))
 		partNumber++
+		rank(filter.Query, "un", "an", "au")
 	}
 	if len(whereParts) > 0 {
 		whereStr := strings.Join(whereParts, " AND ")
 		query += " WHERE " + whereStr
 	}
-	query += " ORDER BY index ASC"
+	if filter.OrderByRelevance && len(rankParts) > 0 {
+		query += " ORDER BY greatest(" + strings.Join(rankParts, ", ") + ") DESC, index ASC"
+	} else {
+		query += " ORDER BY index ASC"
+	}
 	if filter.Limit != 0 {
 		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
 	}
@@ -1403,9 +2091,11 @@ func (db *PostgresIndexerDb) yieldAssetsThread(ctx context.Context, filter Asset
 		var index uint64
 		var creator_addr []byte
 		var paramsJsonStr []byte
+		var createdAt, destroyedAt sql.NullInt64
+		var deleted bool
 		var err error
 
-		err = rows.Scan(&index, &creator_addr, &paramsJsonStr)
+		err = rows.Scan(&index, &creator_addr, &paramsJsonStr, &createdAt, &destroyedAt, &deleted)
 		if err != nil {
 			out <- AssetRow{Error: err}
 			break
@@ -1417,9 +2107,12 @@ func (db *PostgresIndexerDb) yieldAssetsThread(ctx context.Context, filter Asset
 			break
 		}
 		rec := AssetRow{
-			AssetId: index,
-			Creator: creator_addr,
-			Params:  params,
+			AssetId:        index,
+			Creator:        creator_addr,
+			Params:         params,
+			CreatedRound:   nullUint64Ptr(createdAt),
+			DestroyedRound: nullUint64Ptr(destroyedAt),
+			Deleted:        deleted,
 		}
 		select {
 		case <-ctx.Done():
@@ -1451,18 +2144,32 @@ func (db *PostgresIndexerDb) AssetBalances(ctx context.Context, abq AssetBalance
 		whereArgs = append(whereArgs, abq.AmountLT)
 		partNumber++
 	}
+	if !abq.IncludeClosed {
+		whereParts = append(whereParts, "NOT aa.deleted")
+	}
 	if len(abq.PrevAddress) != 0 {
-		whereParts = append(whereParts, fmt.Sprintf("aa.addr > $%d", partNumber))
-		whereArgs = append(whereArgs, abq.PrevAddress)
-		partNumber++
+		if abq.OrderByAmount {
+			whereParts = append(whereParts, fmt.Sprintf("(aa.amount, aa.addr) < ($%d::numeric, $%d)", partNumber, partNumber+1))
+			whereArgs = append(whereArgs, strconv.FormatUint(abq.PrevAmount, 10), abq.PrevAddress)
+			partNumber += 2
+		} else {
+			whereParts = append(whereParts, fmt.Sprintf("aa.addr > $%d", partNumber))
+			whereArgs = append(whereArgs, abq.PrevAddress)
+			partNumber++
+		}
 	}
 	var rows *sql.Rows
 	var err error
-	query := `SELECT addr, assetid, amount, frozen FROM account_asset aa`
+	query := `SELECT addr, assetid, amount, frozen, created_at, closed_at, deleted FROM account_asset aa`
 	if len(whereParts) > 0 {
 		query += " WHERE " + strings.Join(whereParts, " AND ")
 	}
-	query += " ORDER BY addr ASC"
+	if abq.OrderByAmount {
+		// uses account_asset_by_asset_amount_addr
+		query += " ORDER BY amount DESC, addr DESC"
+	} else {
+		query += " ORDER BY addr ASC"
+	}
 	if abq.Limit > 0 {
 		query += fmt.Sprintf(" LIMIT %d", abq.Limit)
 	}
@@ -1483,16 +2190,21 @@ func (db *PostgresIndexerDb) yieldAssetBalanceThread(ctx context.Context, rows *
 		var assetId uint64
 		var amount uint64
 		var frozen bool
-		err := rows.Scan(&addr, &assetId, &amount, &frozen)
+		var created, closed sql.NullInt64
+		var deleted bool
+		err := rows.Scan(&addr, &assetId, &amount, &frozen, &created, &closed, &deleted)
 		if err != nil {
 			out <- AssetBalanceRow{Error: err}
 			break
 		}
 		rec := AssetBalanceRow{
-			Address: addr,
-			AssetId: assetId,
-			Amount:  amount,
-			Frozen:  frozen,
+			Address:      addr,
+			AssetId:      assetId,
+			Amount:       amount,
+			Frozen:       frozen,
+			CreatedRound: nullUint64Ptr(created),
+			ClosedRound:  nullUint64Ptr(closed),
+			Deleted:      deleted,
 		}
 		select {
 		case <-ctx.Done():
@@ -1504,6 +2216,553 @@ func (db *PostgresIndexerDb) yieldAssetBalanceThread(ctx context.Context, rows *
 	close(out)
 }
 
+func (db *PostgresIndexerDb) MultisigAccounts(ctx context.Context, pubkey []byte) <-chan MultisigAccountRow {
+	out := make(chan MultisigAccountRow, 1)
+	rows, err := db.db.QueryContext(ctx, `SELECT m.addr, m.version, m.threshold, m.pubkey FROM msig_account m WHERE m.addr IN (SELECT addr FROM msig_account WHERE pubkey = $1) ORDER BY m.addr, m.idx`, pubkey)
+	if err != nil {
+		out <- MultisigAccountRow{Error: err}
+		close(out)
+		return out
+	}
+	go db.yieldMultisigAccountsThread(ctx, rows, out)
+	return out
+}
+
+func (db *PostgresIndexerDb) yieldMultisigAccountsThread(ctx context.Context, rows *sql.Rows, out chan<- MultisigAccountRow) {
+	defer rows.Close()
+	defer close(out)
+	var rec MultisigAccountRow
+	for rows.Next() {
+		var addr, pk []byte
+		var version, threshold uint8
+		err := rows.Scan(&addr, &version, &threshold, &pk)
+		if err != nil {
+			out <- MultisigAccountRow{Error: err}
+			return
+		}
+		if rec.Address != nil && !bytes.Equal(rec.Address, addr) {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- rec:
+			}
+			rec = MultisigAccountRow{}
+		}
+		rec.Address = addr
+		rec.Version = version
+		rec.Threshold = threshold
+		rec.PublicKeys = append(rec.PublicKeys, pk)
+	}
+	if err := rows.Err(); err != nil {
+		out <- MultisigAccountRow{Error: err}
+		return
+	}
+	if rec.Address != nil {
+		select {
+		case <-ctx.Done():
+		case out <- rec:
+		}
+	}
+}
+
+func (db *PostgresIndexerDb) AuthHistory(ctx context.Context, addr []byte) <-chan AuthHistoryRow {
+	out := make(chan AuthHistoryRow, 1)
+	go func() {
+		defer close(out)
+		var rec AuthHistoryRow
+		err := db.yieldHistory(ctx, `account_auth h`, `h.oldauth, h.newauth`, `h.addr`, addr, []interface{}{&rec.OldAuth, &rec.NewAuth}, func(round uint64, intra int, txid string) bool {
+			rec.Round, rec.Intra, rec.Txid = round, intra, txid
+			select {
+			case <-ctx.Done():
+				return false
+			case out <- rec:
+				return true
+			}
+		})
+		if err != nil {
+			out <- AuthHistoryRow{Error: err}
+		}
+	}()
+	return out
+}
+
+// yieldHistory selects the rows of a history table (account_auth,
+// account_keyreg, asset_config) where key matches, in txn order. For
+// each row cols are scanned into dest and yield is called with the
+// round, intra and txid of the row until it returns false.
+func (db *PostgresIndexerDb) yieldHistory(ctx context.Context, from, cols, keycol string, key interface{}, dest []interface{}, yield func(round uint64, intra int, txid string) bool) error {
+	query := fmt.Sprintf(`SELECT h.round, h.intra, h.txid, %s FROM %s WHERE %s = $1 ORDER BY h.round, h.intra`, cols, from, keycol)
+	rows, err := db.db.QueryContext(ctx, query, key)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var round uint64
+	var intra int
+	var txid []byte
+	dest = append([]interface{}{&round, &intra, &txid}, dest...)
+	for rows.Next() {
+		err = rows.Scan(dest...)
+		if err != nil {
+			return err
+		}
+		if !yield(round, intra, string(txid)) {
+			return nil
+		}
+	}
+	return rows.Err()
+}
+
+func (db *PostgresIndexerDb) StakeTotals(ctx context.Context) (totals StakeTotals, err error) {
+	tx, err := db.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return totals, fmt.Errorf("stake tx, %v", err)
+	}
+	defer tx.Rollback()
+
+	row := tx.QueryRow(`SELECT (v -> 'account_round')::bigint as account_round FROM metastate WHERE k = 'state'`)
+	err = row.Scan(&totals.Round)
+	if err != nil {
+		return totals, fmt.Errorf("account_round, %v", err)
+	}
+	var headerjson []byte
+	err = tx.QueryRow(`SELECT header FROM block_header WHERE round = $1`, totals.Round).Scan(&headerjson)
+	if err != nil {
+		return totals, fmt.Errorf("account round header %d, %v", totals.Round, err)
+	}
+	var blockheader types.Block
+	err = json.Decode(headerjson, &blockheader)
+	if err != nil {
+		return totals, fmt.Errorf("account round header %d, %v", totals.Round, err)
+	}
+	proto, err := db.GetProto(string(blockheader.CurrentProtocol))
+	if err != nil {
+		return totals, fmt.Errorf("stake proto %s, %v", blockheader.CurrentProtocol, err)
+	}
+
+	// pending rewards as in PendingRewards, none without a RewardUnit
+	// and none for NotParticipating accounts
+	rewardUnit := proto.RewardUnit
+	rewardsLevel := blockheader.RewardsLevel
+	if rewardUnit == 0 {
+		rewardUnit = 1
+		rewardsLevel = 0
+	}
+	rows, err := tx.Query(`SELECT coalesce((a.account_data ->> 'onl')::int, 0), sum(a.microalgos), sum(a.microalgos / $1 * greatest($2 - a.rewardsbase, 0)) FROM account a GROUP BY 1`, rewardUnit, rewardsLevel)
+	if err != nil {
+		return totals, fmt.Errorf("stake query, %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var status int
+		var sum, rewards uint64
+		err = rows.Scan(&status, &sum, &rewards)
+		if err != nil {
+			return totals, fmt.Errorf("stake scan, %v", err)
+		}
+		if status != 2 {
+			sum += rewards
+		}
+		switch status {
+		case 1:
+			totals.Online += sum
+		case 2:
+			totals.NotParticipating += sum
+		default:
+			totals.Offline += sum
+		}
+	}
+	return totals, rows.Err()
+}
+
+// likeEscaper escapes the LIKE wildcards and escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// assetLikePattern returns the ILIKE pattern of text for match.
+func assetLikePattern(match AssetMatch, text string) string {
+	text = likeEscaper.Replace(text)
+	switch match {
+	case AssetMatchPrefix:
+		return text + "%"
+	case AssetMatchExact:
+		return text
+	default:
+		return "%" + text + "%"
+	}
+}
+
+// assetRoles returns the asset manager, reserve, freeze and clawback
+// column values of params, nil for disabled roles.
+func assetRoles(params types.AssetParams) []interface{} {
+	roles := []types.Address{params.Manager, params.Reserve, params.Freeze, params.Clawback}
+	out := make([]interface{}, len(roles))
+	for i, role := range roles {
+		if !role.IsZero() {
+			out[i] = role[:]
+		}
+	}
+	return out
+}
+
+// addAssetConfigQuery inserts the assetConfigHistoryRow of an acfg into
+// asset_config. The role addresses of an existing asset's acfg are set
+// on the params of the acfg before it, or of the asset if there is none.
+const addAssetConfigQuery = `INSERT INTO asset_config (assetid, round, intra, txid, sender, params) VALUES ($1, $2, $3, $4, $5,
+(coalesce((SELECT h.params FROM asset_config h WHERE h.assetid = $1 AND (h.round, h.intra) < ($2, $3) ORDER BY h.round DESC, h.intra DESC LIMIT 1), (SELECT a.params FROM asset a WHERE a.index = $1), '{}') - 'm' - 'r' - 'f' - 'c') || $6::jsonb) ON CONFLICT DO NOTHING`
+
+// assetConfigHistoryRow returns the asset_config values for ac.
+func assetConfigHistoryRow(ac AssetConfigUpdate) []interface{} {
+	var params interface{}
+	if !ac.Params.IsZero() {
+		params = string(json.Encode(ac.Params))
+	}
+	return []interface{}{ac.AssetId, ac.Round, ac.Intra, []byte(ac.Txid), ac.Sender[:], params}
+}
+
+func (db *PostgresIndexerDb) AssetConfigHistory(ctx context.Context, assetId uint64) <-chan AssetConfigHistoryRow {
+	out := make(chan AssetConfigHistoryRow, 1)
+	go func() {
+		defer close(out)
+		var rec AssetConfigHistoryRow
+		var paramsjson []byte
+		var perr error
+		err := db.yieldHistory(ctx, `asset_config h JOIN asset a ON a.index = h.assetid`, `h.sender, a.creator_addr, h.params`, `h.assetid`, assetId, []interface{}{&rec.Sender, &rec.Creator, &paramsjson}, func(round uint64, intra int, txid string) bool {
+			rec.Round, rec.Intra, rec.Txid = round, intra, txid
+			rec.Params = nil
+			if paramsjson != nil {
+				rec.Params = new(types.AssetParams)
+				perr = json.Decode(paramsjson, rec.Params)
+				if perr != nil {
+					return false
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return false
+			case out <- rec:
+				return true
+			}
+		})
+		if err == nil {
+			err = perr
+		}
+		if err != nil {
+			out <- AssetConfigHistoryRow{Error: err}
+		}
+	}()
+	return out
+}
+
+func (db *PostgresIndexerDb) AssetSupply(ctx context.Context, assetId uint64) (supply AssetSupply, err error) {
+	tx, err := db.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return supply, fmt.Errorf("supply tx, %v", err)
+	}
+	defer tx.Rollback()
+
+	row := tx.QueryRow(`SELECT (v -> 'account_round')::bigint as account_round FROM metastate WHERE k = 'state'`)
+	err = row.Scan(&supply.Round)
+	if err != nil {
+		return supply, fmt.Errorf("account_round, %v", err)
+	}
+	var paramsjson []byte
+	row = tx.QueryRow(`SELECT a.params,
+coalesce(sum(aa.amount) FILTER (WHERE aa.addr = a.reserve_addr), 0),
+coalesce(sum(aa.amount) FILTER (WHERE aa.addr = a.creator_addr), 0),
+coalesce(sum(aa.amount) FILTER (WHERE aa.frozen), 0),
+count(aa.addr) FILTER (WHERE aa.amount > 0),
+count(aa.addr) FILTER (WHERE aa.amount = 0)
+FROM asset a LEFT JOIN account_asset aa ON aa.assetid = a.index AND NOT aa.deleted WHERE a.index = $1 GROUP BY a.index`, assetId)
+	err = row.Scan(&paramsjson, &supply.Reserve, &supply.Creator, &supply.Frozen, &supply.Holders, &supply.ZeroBalance)
+	if err != nil {
+		return supply, fmt.Errorf("asset %d supply, %v", assetId, err)
+	}
+	var params types.AssetParams
+	err = json.Decode(paramsjson, &params)
+	if err != nil {
+		return supply, fmt.Errorf("asset %d params, %v", assetId, err)
+	}
+	supply.Total = params.Total
+	return supply, nil
+}
+
+// keyregHistoryRow returns the account_keyreg values for kr.
+func keyregHistoryRow(kr KeyregUpdate) []interface{} {
+	row := []interface{}{kr.Addr[:], kr.Round, kr.Intra, []byte(kr.Txid), kr.Status, nil, nil, nil, nil, nil}
+	if kr.Status == 1 {
+		row[5] = kr.VoteKey
+		row[6] = kr.SelectionKey
+		row[7] = kr.VoteFirst
+		row[8] = kr.VoteLast
+		row[9] = kr.VoteKeyDilution
+	}
+	return row
+}
+
+func (db *PostgresIndexerDb) ParticipationHistory(ctx context.Context, addr []byte) <-chan ParticipationHistoryRow {
+	out := make(chan ParticipationHistoryRow, 1)
+	go func() {
+		defer close(out)
+		var rec ParticipationHistoryRow
+		dest := []interface{}{&rec.Status, &rec.VoteKey, &rec.SelectionKey, &rec.VoteFirst, &rec.VoteLast, &rec.VoteKeyDilution}
+		err := db.yieldHistory(ctx, `account_keyreg h`, `h.status, h.votekey, h.selkey, coalesce(h.votefirst, 0), coalesce(h.votelast, 0), coalesce(h.votekd, 0)`, `h.addr`, addr, dest, func(round uint64, intra int, txid string) bool {
+			rec.Round, rec.Intra, rec.Txid = round, intra, txid
+			select {
+			case <-ctx.Done():
+				return false
+			case out <- rec:
+				return true
+			}
+		})
+		if err != nil {
+			out <- ParticipationHistoryRow{Error: err}
+		}
+	}()
+	return out
+}
+
+// statsPeriods are the rollups kept in stats_period and
+// stats_asset_period as well as the per-round ones.
+var statsPeriods = []struct {
+	interval StatsInterval
+	name     string
+	length   time.Duration
+}{
+	{StatsIntervalHour, "hour", time.Hour},
+	{StatsIntervalDay, "day", 24 * time.Hour},
+}
+
+func statsPeriodName(interval StatsInterval) string {
+	for _, sp := range statsPeriods {
+		if sp.interval == interval {
+			return sp.name
+		}
+	}
+	return ""
+}
+
+// commitRoundStats adds a round to the stats tables. An account is
+// counted active in an hour or day when it was not already active
+// in an earlier round of it. Rounds are added in order, except by
+// m14statsBackfill adding those from before the stats began.
+func commitRoundStats(tx *sql.Tx, round uint64, stats RoundStats, newAccounts uint64, prevActive []sql.NullInt64) error {
+	var realtime time.Time
+	err := tx.QueryRow(`SELECT realtime FROM block_header WHERE round = $1`, round).Scan(&realtime)
+	if err != nil {
+		return fmt.Errorf("stats round %d time, %v", round, err)
+	}
+	counts := make([]interface{}, 1, 1+len(StatsTxnTypes))
+	var txns uint64
+	for _, txtype := range StatsTxnTypes {
+		txns += stats.TxnCounts[txtype]
+		counts = append(counts, stats.TxnCounts[txtype])
+	}
+	counts[0] = txns
+
+	args := []interface{}{round, realtime}
+	args = append(args, counts...)
+	args = append(args, stats.AlgoVolume, stats.Fees, newAccounts, len(prevActive))
+	_, err = tx.Exec(`INSERT INTO stats_round (round, realtime, txns, pay_txns, keyreg_txns, acfg_txns, axfer_txns, afrz_txns, algo_volume, fees, new_accounts, active_accounts) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`, args...)
+	if err != nil {
+		return fmt.Errorf("insert stats round, %v", err)
+	}
+
+	setperiod, err := tx.Prepare(`INSERT INTO stats_period AS s (period, start, first_round, last_round, txns, pay_txns, keyreg_txns, acfg_txns, axfer_txns, afrz_txns, algo_volume, fees, new_accounts, active_accounts) VALUES ($1, $2, $3, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) ON CONFLICT (period, start) DO UPDATE SET first_round = least(s.first_round, EXCLUDED.first_round), last_round = greatest(s.last_round, EXCLUDED.last_round),
+txns = s.txns + EXCLUDED.txns, pay_txns = s.pay_txns + EXCLUDED.pay_txns, keyreg_txns = s.keyreg_txns + EXCLUDED.keyreg_txns, acfg_txns = s.acfg_txns + EXCLUDED.acfg_txns, axfer_txns = s.axfer_txns + EXCLUDED.axfer_txns, afrz_txns = s.afrz_txns + EXCLUDED.afrz_txns,
+algo_volume = s.algo_volume + EXCLUDED.algo_volume, fees = s.fees + EXCLUDED.fees, new_accounts = s.new_accounts + EXCLUDED.new_accounts, active_accounts = s.active_accounts + EXCLUDED.active_accounts`)
+	if err != nil {
+		return fmt.Errorf("prepare stats period, %v", err)
+	}
+	defer setperiod.Close()
+	for _, sp := range statsPeriods {
+		start := realtime.Truncate(sp.length)
+		var firstRound uint64
+		err = tx.QueryRow(`SELECT first_round FROM stats_period WHERE period = $1 AND start = $2`, sp.name, start).Scan(&firstRound)
+		if err == sql.ErrNoRows {
+			firstRound = round
+		} else if err != nil {
+			return fmt.Errorf("stats %s first round, %v", sp.name, err)
+		}
+		var active uint64
+		for _, prev := range prevActive {
+			if !prev.Valid || uint64(prev.Int64) < firstRound {
+				active++
+			}
+		}
+		args = []interface{}{sp.name, start, round}
+		args = append(args, counts...)
+		args = append(args, stats.AlgoVolume, stats.Fees, newAccounts, active)
+		_, err = setperiod.Exec(args...)
+		if err != nil {
+			return fmt.Errorf("update stats %s, %v", sp.name, err)
+		}
+	}
+
+	if len(stats.AssetTransfers) == 0 {
+		return nil
+	}
+	setassetround, err := tx.Prepare(`INSERT INTO stats_asset_round (assetid, round, realtime, transfers, volume) VALUES ($1, $2, $3, $4, $5)`)
+	if err != nil {
+		return fmt.Errorf("prepare stats asset round, %v", err)
+	}
+	defer setassetround.Close()
+	setassetperiod, err := tx.Prepare(`INSERT INTO stats_asset_period AS s (assetid, period, start, first_round, last_round, transfers, volume) VALUES ($1, $2, $3, $4, $4, $5, $6) ON CONFLICT (assetid, period, start) DO UPDATE SET first_round = least(s.first_round, EXCLUDED.first_round), last_round = greatest(s.last_round, EXCLUDED.last_round), transfers = s.transfers + EXCLUDED.transfers, volume = s.volume + EXCLUDED.volume`)
+	if err != nil {
+		return fmt.Errorf("prepare stats asset period, %v", err)
+	}
+	defer setassetperiod.Close()
+	for assetId, transfers := range stats.AssetTransfers {
+		volume := "0"
+		if v := stats.AssetVolume[assetId]; v != nil {
+			volume = v.String()
+		}
+		_, err = setassetround.Exec(assetId, round, realtime, transfers, volume)
+		if err != nil {
+			return fmt.Errorf("insert stats asset %d round, %v", assetId, err)
+		}
+		for _, sp := range statsPeriods {
+			_, err = setassetperiod.Exec(assetId, sp.name, realtime.Truncate(sp.length), round, transfers, volume)
+			if err != nil {
+				return fmt.Errorf("update stats asset %d %s, %v", assetId, sp.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// buildStatsQuery selects columns after the first round, last round
+// and start time of the rollups sq asks for, newest first.
+func buildStatsQuery(sq StatsQuery, columns string, asset bool) (query string, whereArgs []interface{}) {
+	table, firstCol, lastCol, startCol := "stats_round", "round", "round", "realtime"
+	if asset {
+		table = "stats_asset_round"
+	}
+	whereParts := make([]string, 0, 6)
+	partNumber := 1
+	if sq.Interval != StatsIntervalRound {
+		table = "stats_period"
+		if asset {
+			table = "stats_asset_period"
+		}
+		firstCol, lastCol, startCol = "first_round", "last_round", "start"
+		whereParts = append(whereParts, fmt.Sprintf("period = $%d", partNumber))
+		whereArgs = append(whereArgs, statsPeriodName(sq.Interval))
+		partNumber++
+	}
+	if asset {
+		whereParts = append(whereParts, fmt.Sprintf("assetid = $%d", partNumber))
+		whereArgs = append(whereArgs, sq.AssetId)
+		partNumber++
+	}
+	if sq.MinRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("%s >= $%d", lastCol, partNumber))
+		whereArgs = append(whereArgs, sq.MinRound)
+		partNumber++
+	}
+	if sq.MaxRound != 0 {
+		whereParts = append(whereParts, fmt.Sprintf("%s <= $%d", firstCol, partNumber))
+		whereArgs = append(whereArgs, sq.MaxRound)
+		partNumber++
+	}
+	if !sq.AfterTime.IsZero() {
+		whereParts = append(whereParts, fmt.Sprintf("%s >= $%d", startCol, partNumber))
+		whereArgs = append(whereArgs, sq.AfterTime)
+		partNumber++
+	}
+	if !sq.BeforeTime.IsZero() {
+		whereParts = append(whereParts, fmt.Sprintf("%s < $%d", startCol, partNumber))
+		whereArgs = append(whereArgs, sq.BeforeTime)
+		partNumber++
+	}
+	query = fmt.Sprintf("SELECT %s, %s, %s, %s FROM %s", firstCol, lastCol, startCol, columns, table)
+	if len(whereParts) > 0 {
+		query += " WHERE " + strings.Join(whereParts, " AND ")
+	}
+	query += " ORDER BY " + firstCol + " DESC"
+	if sq.Limit != 0 {
+		query += fmt.Sprintf(" LIMIT %d", sq.Limit)
+	}
+	return
+}
+
+func (db *PostgresIndexerDb) Stats(ctx context.Context, sq StatsQuery) <-chan StatsRow {
+	out := make(chan StatsRow, 1)
+	query, whereArgs := buildStatsQuery(sq, "txns, pay_txns, keyreg_txns, acfg_txns, axfer_txns, afrz_txns, least(algo_volume, 18446744073709551615), least(fees, 18446744073709551615), new_accounts, active_accounts", false)
+	rows, err := db.db.QueryContext(ctx, query, whereArgs...)
+	if err != nil {
+		out <- StatsRow{Error: err}
+		close(out)
+		return out
+	}
+	go db.yieldStatsThread(ctx, rows, out)
+	return out
+}
+
+func (db *PostgresIndexerDb) yieldStatsThread(ctx context.Context, rows *sql.Rows, out chan<- StatsRow) {
+	defer rows.Close()
+	defer close(out)
+	for rows.Next() {
+		var rec StatsRow
+		counts := make([]uint64, len(StatsTxnTypes))
+		dest := []interface{}{&rec.FirstRound, &rec.LastRound, &rec.Start, &rec.Txns}
+		for i := range counts {
+			dest = append(dest, &counts[i])
+		}
+		dest = append(dest, &rec.AlgoVolume, &rec.Fees, &rec.NewAccounts, &rec.ActiveAccounts)
+		err := rows.Scan(dest...)
+		if err != nil {
+			out <- StatsRow{Error: err}
+			return
+		}
+		rec.TxnCounts = make(map[string]uint64, len(StatsTxnTypes))
+		for i, txtype := range StatsTxnTypes {
+			rec.TxnCounts[txtype] = counts[i]
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case out <- rec:
+		}
+	}
+	if err := rows.Err(); err != nil {
+		out <- StatsRow{Error: err}
+	}
+}
+
+func (db *PostgresIndexerDb) AssetStats(ctx context.Context, sq StatsQuery) <-chan AssetStatsRow {
+	out := make(chan AssetStatsRow, 1)
+	query, whereArgs := buildStatsQuery(sq, "transfers, least(volume, 18446744073709551615)", true)
+	rows, err := db.db.QueryContext(ctx, query, whereArgs...)
+	if err != nil {
+		out <- AssetStatsRow{Error: err}
+		close(out)
+		return out
+	}
+	go db.yieldAssetStatsThread(ctx, rows, out)
+	return out
+}
+
+func (db *PostgresIndexerDb) yieldAssetStatsThread(ctx context.Context, rows *sql.Rows, out chan<- AssetStatsRow) {
+	defer rows.Close()
+	defer close(out)
+	for rows.Next() {
+		var rec AssetStatsRow
+		err := rows.Scan(&rec.FirstRound, &rec.LastRound, &rec.Start, &rec.Transfers, &rec.Volume)
+		if err != nil {
+			out <- AssetStatsRow{Error: err}
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case out <- rec:
+		}
+	}
+	if err := rows.Err(); err != nil {
+		out <- AssetStatsRow{Error: err}
+	}
+}
+
 type postgresFactory struct {
 }
 
@@ -1526,3 +2785,15 @@ func ParseImportState(js string) (istate ImportState, err error) {
 	err = json.Decode([]byte(js), &istate)
 	return
 }
+
+// RetentionState is kept in metastate "retention" once transactions have been pruned
+// or a snapshot has been loaded.
+type RetentionState struct {
+	// EarliestRound is the oldest round for which transactions are still stored.
+	EarliestRound uint64 `codec:"earliest_round"`
+}
+
+func ParseRetentionState(js string) (rstate RetentionState, err error) {
+	err = json.Decode([]byte(js), &rstate)
+	return
+}
@@ -0,0 +1,626 @@
+// You can build without postgres by `go build --tags nopostgres` but it's on by default
+// +build !nopostgres
+
+package idb
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/algorand/go-algorand-sdk/crypto"
+	"github.com/algorand/go-algorand-sdk/encoding/json"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	atypes "github.com/algorand/go-algorand-sdk/types"
+
+	"github.com/algorand/indexer/types"
+)
+
+// MigrationState is kept in metastate "migration".
+type MigrationState struct {
+	// NextMigration is the index in migrations of the first one not yet applied.
+	NextMigration int `codec:"next"`
+
+	// NextRound lets a long running migration resume where it left off.
+	NextRound int64 `codec:"round,omitempty"`
+
+	// EndRound is where a migration that stops short of the latest
+	// round decided to stop when it began, kept for when it resumes.
+	EndRound int64 `codec:"end,omitempty"`
+}
+
+type migrationFunc func(db *PostgresIndexerDb, state *MigrationState) error
+
+// migrations are applied in order after setup_postgres.sql has
+// brought the schema up to date. Append new ones to the end, never
+// reorder or remove them.
+var migrations = []migrationFunc{
+	m0txnColumns,
+	m1txnSearchIndexes,
+	m2txnGroup,
+	m3participationRoles,
+	m4lsigHash,
+	m5msigParticipants,
+	m6authHistory,
+	m7keyregHistory,
+	m8rewardedMicroAlgos,
+	m9accountLifecycle,
+	m10assetLifecycle,
+	m11assetConfigHistory,
+	m12assetRoles,
+	m13participationRoundIndex,
+	m14statsBackfill,
+	m15assetAmountAddrIndex,
+}
+
+func (db *PostgresIndexerDb) migrate() (err error) {
+	stateJsonStr, err := db.GetMetastate("migration")
+	if err != nil {
+		return fmt.Errorf("getting migration state, %v", err)
+	}
+	var state MigrationState
+	if stateJsonStr != "" {
+		err = json.Decode([]byte(stateJsonStr), &state)
+		if err != nil {
+			return fmt.Errorf("parsing migration state, %v", err)
+		}
+	}
+	for state.NextMigration < len(migrations) {
+		fmt.Fprintf(os.Stderr, "db migration %d\n", state.NextMigration)
+		err = migrations[state.NextMigration](db, &state)
+		if err != nil {
+			return fmt.Errorf("db migration %d, %v", state.NextMigration, err)
+		}
+		state.NextMigration++
+		state.NextRound = 0
+		state.EndRound = 0
+		err = db.setMigrationState(nil, state)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setMigrationState saves state, as part of tx if it is not nil.
+func (db *PostgresIndexerDb) setMigrationState(tx *sql.Tx, state MigrationState) (err error) {
+	const setq = `INSERT INTO metastate (k, v) VALUES ('migration', $1) ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v`
+	sj := string(json.Encode(state))
+	if tx != nil {
+		_, err = tx.Exec(setq, sj)
+	} else {
+		_, err = db.db.Exec(setq, sj)
+	}
+	if err != nil {
+		return fmt.Errorf("saving migration state, %v", err)
+	}
+	return nil
+}
+
+// Rows are rewritten this many rounds at a time by migrations that
+// visit every transaction.
+const migrationBatchRounds = 1000
+
+// forEachTxnBatch calls f with a transaction and the txns of each batch
+// of rounds from state.NextRound through the current max round,
+// committing progress after each batch. Rows have the txn asset column
+// in AssetId.
+func (db *PostgresIndexerDb) forEachTxnBatch(state *MigrationState, f func(tx *sql.Tx, rows []TxnRow) error) (err error) {
+	var maxRound sql.NullInt64
+	err = db.db.QueryRow(`SELECT max(round) FROM txn`).Scan(&maxRound)
+	if err != nil {
+		return fmt.Errorf("getting max txn round, %v", err)
+	}
+	if !maxRound.Valid {
+		// no transactions
+		return nil
+	}
+	for state.NextRound <= maxRound.Int64 {
+		end := state.NextRound + migrationBatchRounds
+		rows, err := db.db.Query(`SELECT round, intra, asset, txnbytes FROM txn WHERE round >= $1 AND round < $2 ORDER BY round, intra`, state.NextRound, end)
+		if err != nil {
+			return fmt.Errorf("txn batch at %d, %v", state.NextRound, err)
+		}
+		batch := make([]TxnRow, 0, 1000)
+		for rows.Next() {
+			var row TxnRow
+			err = rows.Scan(&row.Round, &row.Intra, &row.AssetId, &row.TxnBytes)
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("txn batch at %d, %v", state.NextRound, err)
+			}
+			batch = append(batch, row)
+		}
+		rows.Close()
+
+		tx, err := db.db.Begin()
+		if err != nil {
+			return err
+		}
+		err = f(tx, batch)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		state.NextRound = end
+		err = db.setMigrationState(tx, *state)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		err = tx.Commit()
+		if err != nil {
+			return fmt.Errorf("txn batch at %d commit, %v", end, err)
+		}
+		fmt.Fprintf(os.Stderr, "migrated txns through round %d of %d\n", end-1, maxRound.Int64)
+	}
+	return nil
+}
+
+// m0txnColumns fills in the txn search columns for transactions
+// imported before they existed.
+func m0txnColumns(db *PostgresIndexerDb, state *MigrationState) error {
+	return db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		setCols, err := tx.Prepare(`UPDATE txn SET amount = $1, closeamount = $2, assetamount = $3, note = $4, sigtype = $5, rekeyto = $6 WHERE round = $7 AND intra = $8`)
+		if err != nil {
+			return err
+		}
+		defer setCols.Close()
+		for _, row := range rows {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			// only the columns that existed when this was added, txgroup and
+			// lsighash are filled in by m2txnGroup and m4lsigHash
+			args := append(txnColumns(&stxn)[:6], row.Round, row.Intra)
+			_, err = setCols.Exec(args...)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d update, %v", row.Round, row.Intra, err)
+			}
+		}
+		return nil
+	})
+}
+
+// m1txnSearchIndexes indexes the columns filled in by m0txnColumns. This
+// is done after backfilling so that the backfill doesn't have to
+// maintain the indexes as it goes.
+func m1txnSearchIndexes(db *PostgresIndexerDb, state *MigrationState) error {
+	indexes := []string{
+		// AlgosGT, AlgosLT
+		`CREATE INDEX IF NOT EXISTS txn_amount ON txn ( amount ) WHERE amount IS NOT NULL`,
+		// EffectiveAmountGt, EffectiveAmountLt, must match the expression in buildTransactionQuery
+		`CREATE INDEX IF NOT EXISTS txn_effective_amount ON txn ( (amount + coalesce(closeamount, 0)) ) WHERE amount IS NOT NULL`,
+		// AssetId with AssetAmountGT, AssetAmountLT
+		`CREATE INDEX IF NOT EXISTS txn_asset_amount ON txn ( asset, assetamount ) WHERE assetamount IS NOT NULL`,
+		// NotePrefix
+		`CREATE INDEX IF NOT EXISTS txn_note ON txn ( note ) WHERE note IS NOT NULL`,
+		// SigType, only the less common kinds are worth an index
+		`CREATE INDEX IF NOT EXISTS txn_sigtype ON txn ( sigtype, round, intra ) WHERE sigtype <> 'sig'`,
+		// RekeyTo
+		`CREATE INDEX IF NOT EXISTS txn_rekeyto ON txn ( round, intra ) WHERE rekeyto IS NOT NULL`,
+	}
+	for _, cmd := range indexes {
+		_, err := db.db.Exec(cmd)
+		if err != nil {
+			return fmt.Errorf("%s, %v", cmd, err)
+		}
+	}
+	return nil
+}
+
+// m2txnGroup fills in and indexes txgroup, which was added after
+// m0txnColumns may have already run.
+func m2txnGroup(db *PostgresIndexerDb, state *MigrationState) error {
+	err := db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		setGroup, err := tx.Prepare(`UPDATE txn SET txgroup = $1 WHERE round = $2 AND intra = $3`)
+		if err != nil {
+			return err
+		}
+		defer setGroup.Close()
+		for _, row := range rows {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			if stxn.Txn.Group == (atypes.Digest{}) {
+				continue
+			}
+			_, err = setGroup.Exec(stxn.Txn.Group[:], row.Round, row.Intra)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d update, %v", row.Round, row.Intra, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.db.Exec(`CREATE INDEX IF NOT EXISTS txn_txgroup ON txn ( txgroup ) WHERE txgroup IS NOT NULL`)
+	return err
+}
+
+// m3participationRoles sets the txn_participation role of every
+// address in transactions imported before it existed, adding rows for
+// the address fields that weren't recorded before: afrz FreezeAccount,
+// the acfg asset param addresses, and RekeyTo.
+func m3participationRoles(db *PostgresIndexerDb, state *MigrationState) error {
+	return db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		upsert, err := tx.Prepare(`INSERT INTO txn_participation (addr, round, intra, role) VALUES ($1, $2, $3, $4) ON CONFLICT (addr, round, intra) DO UPDATE SET role = EXCLUDED.role`)
+		if err != nil {
+			return err
+		}
+		defer upsert.Close()
+		for _, row := range rows {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			addrs := []atypes.Address{
+				stxn.Txn.Sender,
+				stxn.Txn.Receiver,
+				stxn.Txn.CloseRemainderTo,
+				stxn.Txn.AssetSender,
+				stxn.Txn.AssetReceiver,
+				stxn.Txn.AssetCloseTo,
+				stxn.Txn.FreezeAccount,
+				stxn.Txn.AssetParams.Manager,
+				stxn.Txn.AssetParams.Reserve,
+				stxn.Txn.AssetParams.Freeze,
+				stxn.Txn.AssetParams.Clawback,
+				stxn.Txn.RekeyTo,
+			}
+			for _, addr := range addrs {
+				if addr.IsZero() {
+					continue
+				}
+				_, err = upsert.Exec(addr[:], row.Round, row.Intra, AddressRoles(&stxn, addr[:]))
+				if err != nil {
+					return fmt.Errorf("txn r=%d i=%d participation upsert, %v", row.Round, row.Intra, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// m4lsigHash fills in and indexes the logic sig program hash of txns,
+// and the program of escrow accounts.
+func m4lsigHash(db *PostgresIndexerDb, state *MigrationState) error {
+	err := db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		setHash, err := tx.Prepare(`UPDATE txn SET lsighash = $1 WHERE round = $2 AND intra = $3`)
+		if err != nil {
+			return err
+		}
+		defer setHash.Close()
+		setProgram, err := tx.Prepare(`UPDATE account SET lsigprogram = $1 WHERE addr = $2 AND keytype = 'lsig'`)
+		if err != nil {
+			return err
+		}
+		defer setProgram.Close()
+		for _, row := range rows {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			if len(stxn.Lsig.Logic) == 0 {
+				continue
+			}
+			ph := crypto.AddressFromProgram(stxn.Lsig.Logic)
+			_, err = setHash.Exec(ph[:], row.Round, row.Intra)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d update, %v", row.Round, row.Intra, err)
+			}
+			if stxn.Lsig.Sig == (atypes.Signature{}) && stxn.Lsig.Msig.Blank() {
+				// not a delegated lsig, the same rule accounting uses for keytype
+				_, err = setProgram.Exec(stxn.Lsig.Logic, stxn.Txn.Sender[:])
+				if err != nil {
+					return fmt.Errorf("txn r=%d i=%d account update, %v", row.Round, row.Intra, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	_, err = db.db.Exec(`CREATE INDEX IF NOT EXISTS txn_lsighash ON txn ( lsighash, round, intra ) WHERE lsighash IS NOT NULL`)
+	return err
+}
+
+// m5msigParticipants fills in txn_msig and msig_account for txns imported
+// before they existed.
+func m5msigParticipants(db *PostgresIndexerDb, state *MigrationState) error {
+	return db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		addtxmsig, err := tx.Prepare(`INSERT INTO txn_msig (pubkey, round, intra) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`)
+		if err != nil {
+			return err
+		}
+		defer addtxmsig.Close()
+		addmsig, err := tx.Prepare(`INSERT INTO msig_account (addr, idx, pubkey, version, threshold) VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`)
+		if err != nil {
+			return err
+		}
+		defer addmsig.Close()
+		for _, row := range rows {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			txmrows, msrows := msigRows(&stxn, row.Round, row.Intra)
+			for _, txmr := range txmrows {
+				_, err = addtxmsig.Exec(txmr...)
+				if err != nil {
+					return fmt.Errorf("txn r=%d i=%d txn_msig insert, %v", row.Round, row.Intra, err)
+				}
+			}
+			for _, msr := range msrows {
+				_, err = addmsig.Exec(msr...)
+				if err != nil {
+					return fmt.Errorf("txn r=%d i=%d msig_account insert, %v", row.Round, row.Intra, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// m6authHistory fills in account_auth from the rekeys of stored txns.
+// The sender is the txn_participation row with the sender role, and
+// each rekey's old auth is the new auth of the one before it.
+func m6authHistory(db *PostgresIndexerDb, state *MigrationState) error {
+	_, err := db.db.Exec(`INSERT INTO account_auth (addr, round, intra, txid, oldauth, newauth)
+SELECT x.addr, x.round, x.intra, x.txid, lag(x.newauth) OVER (PARTITION BY x.addr ORDER BY x.round, x.intra), x.newauth
+FROM (SELECT p.addr, t.round, t.intra, t.txid, nullif(t.rekeyto, p.addr) AS newauth FROM txn t JOIN txn_participation p ON p.round = t.round AND p.intra = t.intra AND (p.role & $1) <> 0 WHERE t.rekeyto IS NOT NULL) x
+ON CONFLICT DO NOTHING`, AddressRoleSender)
+	return err
+}
+
+// m7keyregHistory fills in account_keyreg from stored keyreg txns.
+func m7keyregHistory(db *PostgresIndexerDb, state *MigrationState) error {
+	return db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		addkeyreg, err := tx.Prepare(`INSERT INTO account_keyreg (addr, round, intra, txid, status, votekey, selkey, votefirst, votelast, votekd) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT DO NOTHING`)
+		if err != nil {
+			return err
+		}
+		defer addkeyreg.Close()
+		for _, row := range rows {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			if stxn.Txn.Type != "keyreg" {
+				continue
+			}
+			kr := NewKeyregUpdate(&stxn, row.Round, row.Intra)
+			_, err = addkeyreg.Exec(keyregHistoryRow(kr)...)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d keyreg history insert, %v", row.Round, row.Intra, err)
+			}
+		}
+		return nil
+	})
+}
+
+// m8rewardedMicroAlgos adds the rewards credited by stored txns that
+// have been applied to accounts to account_data "ern", which accounting
+// didn't keep before. Accounts loaded from a snapshot have "ern" as of
+// the snapshot, and txns from before it are not expected to be stored.
+func m8rewardedMicroAlgos(db *PostgresIndexerDb, state *MigrationState) error {
+	var accountRound int64
+	err := db.db.QueryRow(`SELECT (v -> 'account_round')::bigint FROM metastate WHERE k = 'state'`).Scan(&accountRound)
+	if err == sql.ErrNoRows {
+		// nothing applied to accounts yet
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting account round, %v", err)
+	}
+	return db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		rewards := make(map[[32]byte]uint64)
+		for _, row := range rows {
+			if int64(row.Round) > accountRound {
+				continue
+			}
+			var stxn types.SignedTxnWithAD
+			err := msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			rewards[stxn.Txn.Sender] += uint64(stxn.SenderRewards)
+			rewards[stxn.Txn.Receiver] += uint64(stxn.ReceiverRewards)
+			rewards[stxn.Txn.CloseRemainderTo] += uint64(stxn.CloseRewards)
+		}
+		setrewards, err := tx.Prepare(addRewardedQuery)
+		if err != nil {
+			return err
+		}
+		defer setrewards.Close()
+		for addr, amount := range rewards {
+			if amount == 0 {
+				continue
+			}
+			_, err = setrewards.Exec(amount, addr[:])
+			if err != nil {
+				return fmt.Errorf("update rewards, %v", err)
+			}
+		}
+		return nil
+	})
+}
+
+// m9accountLifecycle fills in account created_at and last_active from
+// stored txns, and marks accounts without algos, which must have been
+// closed, as deleted. Closed out asset holdings were deleted before
+// and are gone.
+func m9accountLifecycle(db *PostgresIndexerDb, state *MigrationState) error {
+	algoRoles := AddressRoleSender | AddressRoleReceiver | AddressRoleCloseRemainderTo
+	_, err := db.db.Exec(`UPDATE account a SET created_at = coalesce(a.created_at, x.first), last_active = coalesce(a.last_active, x.last)
+FROM (SELECT p.addr, min(p.round) AS first, max(p.round) FILTER (WHERE (p.role & $2) <> 0) AS last FROM txn_participation p WHERE (p.role & $1) <> 0 GROUP BY p.addr) x
+WHERE a.addr = x.addr`, algoRoles, AddressRoleSender)
+	if err != nil {
+		return fmt.Errorf("account created and last active, %v", err)
+	}
+	_, err = db.db.Exec(`UPDATE account SET deleted = true WHERE microalgos = 0`)
+	if err != nil {
+		return fmt.Errorf("account deleted, %v", err)
+	}
+	return nil
+}
+
+// m10assetLifecycle fills in asset created_at, and destroyed_at for
+// assets destroyed by a stored acfg txn. Holdings of destroyed assets
+// were deleted before and are gone.
+func m10assetLifecycle(db *PostgresIndexerDb, state *MigrationState) error {
+	_, err := db.db.Exec(`UPDATE asset a SET created_at = x.round
+FROM (SELECT t.asset, min(t.round) AS round FROM txn t WHERE t.typeenum = 3 AND t.asset <> 0 GROUP BY t.asset) x
+WHERE a.index = x.asset AND a.created_at IS NULL`)
+	if err != nil {
+		return fmt.Errorf("asset created, %v", err)
+	}
+	return db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		setdestroyed, err := tx.Prepare(`UPDATE asset SET destroyed_at = $2, deleted = true WHERE index = $1`)
+		if err != nil {
+			return err
+		}
+		defer setdestroyed.Close()
+		for _, row := range rows {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			if stxn.Txn.Type != "acfg" || row.AssetId == 0 || !stxn.Txn.AssetParams.IsZero() {
+				continue
+			}
+			_, err = setdestroyed.Exec(row.AssetId, row.Round)
+			if err != nil {
+				return fmt.Errorf("asset %d destroyed, %v", row.AssetId, err)
+			}
+		}
+		return nil
+	})
+}
+
+// m11assetConfigHistory fills in asset_config from stored acfg txns,
+// setting the role addresses of reconfigures as accounting does.
+// Creations that accounting hasn't set txn.asset for yet are left for
+// accounting to record.
+func m11assetConfigHistory(db *PostgresIndexerDb, state *MigrationState) error {
+	return db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		addconfig, err := tx.Prepare(addAssetConfigQuery)
+		if err != nil {
+			return err
+		}
+		defer addconfig.Close()
+		for _, row := range rows {
+			var stxn types.SignedTxnWithAD
+			err = msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			if stxn.Txn.Type != "acfg" || row.AssetId == 0 {
+				continue
+			}
+			ac := AssetConfigUpdate{
+				AssetId: row.AssetId,
+				Sender:  stxn.Txn.Sender,
+				Params:  stxn.Txn.AssetParams,
+				Round:   row.Round,
+				Intra:   row.Intra,
+				Txid:    crypto.TransactionIDString(stxn.Txn),
+			}
+			if stxn.Txn.ConfigAsset != 0 {
+				ac.Params = RoleParams(ac.Params)
+			}
+			_, err = addconfig.Exec(assetConfigHistoryRow(ac)...)
+			if err != nil {
+				return fmt.Errorf("asset %d config r=%d i=%d, %v", ac.AssetId, ac.Round, ac.Intra, err)
+			}
+		}
+		return nil
+	})
+}
+
+// m12assetRoles fills in the asset role address columns from params,
+// which has the addresses in base64.
+func m12assetRoles(db *PostgresIndexerDb, state *MigrationState) error {
+	_, err := db.db.Exec(`UPDATE asset SET manager_addr = decode(params ->> 'm', 'base64'), reserve_addr = decode(params ->> 'r', 'base64'),
+freeze_addr = decode(params ->> 'f', 'base64'), clawback_addr = decode(params ->> 'c', 'base64')`)
+	if err != nil {
+		return fmt.Errorf("asset roles, %v", err)
+	}
+	return nil
+}
+
+// m13participationRoundIndex indexes txn_participation by round so that
+// PruneTransactions can delete it a batch of rounds at a time.
+func m13participationRoundIndex(db *PostgresIndexerDb, state *MigrationState) error {
+	_, err := db.db.Exec(`CREATE INDEX IF NOT EXISTS txn_participation_round ON txn_participation ( round )`)
+	return err
+}
+
+// m14statsBackfill adds the txn counts, volumes and fees of stored
+// txns applied to accounts before the stats tables were kept. Accounts
+// opened and active in those rounds are not known, and are left 0.
+func m14statsBackfill(db *PostgresIndexerDb, state *MigrationState) error {
+	if state.EndRound == 0 {
+		// rounds before the first with stats, or all applied rounds if none have any,
+		// saved before accounting adds more so that a resumed backfill stops in the same place
+		var end sql.NullInt64
+		err := db.db.QueryRow(`SELECT coalesce((SELECT min(round) FROM stats_round), (SELECT (v -> 'account_round')::bigint + 1 FROM metastate WHERE k = 'state'))`).Scan(&end)
+		if err != nil {
+			return fmt.Errorf("getting stats start, %v", err)
+		}
+		if !end.Valid || end.Int64 == 0 {
+			// nothing to backfill
+			return nil
+		}
+		state.EndRound = end.Int64
+		err = db.setMigrationState(nil, *state)
+		if err != nil {
+			return err
+		}
+	}
+	return db.forEachTxnBatch(state, func(tx *sql.Tx, rows []TxnRow) error {
+		var stats RoundStats
+		for i, row := range rows {
+			if int64(row.Round) >= state.EndRound {
+				break
+			}
+			var stxn types.SignedTxnWithAD
+			err := msgpack.Decode(row.TxnBytes, &stxn)
+			if err != nil {
+				return fmt.Errorf("txn r=%d i=%d decode, %v", row.Round, row.Intra, err)
+			}
+			stats.Add(&stxn)
+			if i+1 == len(rows) || rows[i+1].Round != row.Round {
+				err = commitRoundStats(tx, row.Round, stats, 0, nil)
+				if err != nil {
+					return err
+				}
+				stats = RoundStats{}
+			}
+		}
+		return nil
+	})
+}
+
+// m15assetAmountAddrIndex replaces the account_asset (assetid, amount)
+// index with one that also has addr, so that AssetBalances pages by
+// amount and address come from the index without sorting the holders.
+func m15assetAmountAddrIndex(db *PostgresIndexerDb, state *MigrationState) error {
+	_, err := db.db.Exec(`CREATE INDEX IF NOT EXISTS account_asset_by_asset_amount_addr ON account_asset ( assetid, amount, addr );
+DROP INDEX IF EXISTS account_asset_by_asset_amount`)
+	if err != nil {
+		return fmt.Errorf("asset amount addr index, %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+// +build !nopostgres
+
+package idb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/encoding/json"
+	"github.com/algorand/go-algorand-sdk/encoding/msgpack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/indexer/types"
+)
+
+func TestAssetLikePattern(t *testing.T) {
+	tests := []struct {
+		match    AssetMatch
+		text     string
+		expected string
+	}{
+		{AssetMatchSubstring, "gold", "%gold%"},
+		{AssetMatchPrefix, "gold", "gold%"},
+		{AssetMatchExact, "gold", "gold"},
+		{AssetMatchSubstring, `100%_pure\`, `%100\%\_pure\\%`},
+		{AssetMatchPrefix, "a_b", `a\_b%`},
+		{AssetMatchExact, "50%", `50\%`},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, assetLikePattern(test.match, test.text), "%d %q", test.match, test.text)
+	}
+}
+
+// setupTestPostgres opens the database named by INDEXER_TEST_POSTGRES
+// after emptying it, or skips the test when it is not set.
+func setupTestPostgres(t *testing.T) (*PostgresIndexerDb, func()) {
+	connection := os.Getenv("INDEXER_TEST_POSTGRES")
+	if connection == "" {
+		t.Skip("INDEXER_TEST_POSTGRES not set to a database the tests may empty")
+	}
+	raw, err := sql.Open("postgres", connection)
+	require.NoError(t, err)
+	_, err = raw.Exec(`DROP SCHEMA public CASCADE; CREATE SCHEMA public`)
+	raw.Close()
+	require.NoError(t, err)
+	db, err := OpenPostgres(connection)
+	require.NoError(t, err)
+	return db, func() { db.db.Close() }
+}
+
+// setAccountRound sets the accounting round, with a block header and
+// a protocol without rewards for it.
+func setAccountRound(t *testing.T, db *PostgresIndexerDb, round uint64) {
+	_, err := db.db.Exec(`INSERT INTO metastate (k, v) VALUES ('state', $1) ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v`, `{"account_round": `+strconv.FormatUint(round, 10)+`}`)
+	require.NoError(t, err)
+	_, err = db.db.Exec(`INSERT INTO protocol (version, proto) VALUES ('test', '{}') ON CONFLICT DO NOTHING`)
+	require.NoError(t, err)
+	_, err = db.db.Exec(`INSERT INTO block_header (round, realtime, rewardslevel, header) VALUES ($1, $2, 0, '{"proto": "test"}') ON CONFLICT DO NOTHING`, round, time.Unix(0, 0).UTC())
+	require.NoError(t, err)
+}
+
+// testAddr returns an address of 32 b bytes.
+func testAddr(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func TestCommitRoundStats(t *testing.T) {
+	db, closeDb := setupTestPostgres(t)
+	defer closeDb()
+
+	hour := time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC)
+	for round := uint64(1); round <= 3; round++ {
+		_, err := db.db.Exec(`INSERT INTO block_header (round, realtime, rewardslevel, header) VALUES ($1, $2, 0, '{}')`, round, hour.Add(time.Duration(round)*time.Minute))
+		require.NoError(t, err)
+	}
+	commit := func(round uint64, stats RoundStats, newAccounts uint64, prevActive []sql.NullInt64) {
+		tx, err := db.db.Begin()
+		require.NoError(t, err)
+		defer tx.Rollback()
+		require.NoError(t, commitRoundStats(tx, round, stats, newAccounts, prevActive))
+		require.NoError(t, tx.Commit())
+	}
+	pay := RoundStats{TxnCounts: map[string]uint64{"pay": 1}, AlgoVolume: 100, Fees: 1000}
+	// round 3 first and then round 2, as m14statsBackfill adds it
+	commit(3, pay, 1, []sql.NullInt64{{}})
+	commit(2, pay, 0, []sql.NullInt64{{Int64: 1, Valid: true}})
+
+	var rows []StatsRow
+	for row := range db.Stats(context.Background(), StatsQuery{Interval: StatsIntervalHour}) {
+		require.NoError(t, row.Error)
+		rows = append(rows, row)
+	}
+	require.Len(t, rows, 1)
+	assert.Equal(t, uint64(2), rows[0].FirstRound)
+	assert.Equal(t, uint64(3), rows[0].LastRound)
+	assert.True(t, hour.Equal(rows[0].Start))
+	assert.Equal(t, uint64(2), rows[0].Txns)
+	assert.Equal(t, uint64(2), rows[0].TxnCounts["pay"])
+	assert.Equal(t, uint64(200), rows[0].AlgoVolume)
+	assert.Equal(t, uint64(2000), rows[0].Fees)
+	assert.Equal(t, uint64(1), rows[0].NewAccounts)
+	assert.Equal(t, uint64(2), rows[0].ActiveAccounts)
+
+	rows = nil
+	for row := range db.Stats(context.Background(), StatsQuery{Interval: StatsIntervalRound}) {
+		require.NoError(t, row.Error)
+		rows = append(rows, row)
+	}
+	require.Len(t, rows, 2)
+	assert.Equal(t, uint64(3), rows[0].FirstRound)
+	assert.Equal(t, uint64(2), rows[1].FirstRound)
+	assert.Equal(t, uint64(1), rows[1].ActiveAccounts)
+}
+
+func TestAssetSupply(t *testing.T) {
+	db, closeDb := setupTestPostgres(t)
+	defer closeDb()
+	setAccountRound(t, db, 10)
+
+	creator := testAddr('c')
+	reserve := testAddr('r')
+	holder := testAddr('h')
+	optedIn := testAddr('o')
+	closed := testAddr('x')
+	_, err := db.db.Exec(`INSERT INTO asset (index, creator_addr, params, reserve_addr) VALUES (7, $1, '{"t": 1000}', $2)`, creator, reserve)
+	require.NoError(t, err)
+	holdings := []struct {
+		addr    []byte
+		amount  uint64
+		frozen  bool
+		deleted bool
+	}{
+		{creator, 500, false, false},
+		{reserve, 300, false, false},
+		{holder, 200, true, false},
+		{optedIn, 0, false, false},
+		{closed, 0, false, true},
+	}
+	for _, h := range holdings {
+		_, err = db.db.Exec(`INSERT INTO account_asset (addr, assetid, amount, frozen, deleted) VALUES ($1, 7, $2, $3, $4)`, h.addr, h.amount, h.frozen, h.deleted)
+		require.NoError(t, err)
+	}
+
+	supply, err := db.AssetSupply(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, AssetSupply{Round: 10, Total: 1000, Reserve: 300, Creator: 500, Frozen: 200, Holders: 3, ZeroBalance: 1}, supply)
+
+	_, err = db.AssetSupply(context.Background(), 8)
+	assert.Error(t, err)
+}
+
+func TestAssetBalancesIncludeClosed(t *testing.T) {
+	db, closeDb := setupTestPostgres(t)
+	defer closeDb()
+
+	open := testAddr('p')
+	closed := testAddr('x')
+	_, err := db.db.Exec(`INSERT INTO account_asset (addr, assetid, amount, frozen, created_at, closed_at, deleted) VALUES ($1, 7, 5, false, 2, NULL, false), ($2, 7, 0, false, 3, 4, true)`, open, closed)
+	require.NoError(t, err)
+
+	balances := func(includeClosed bool) (rows []AssetBalanceRow) {
+		for row := range db.AssetBalances(context.Background(), AssetBalanceQuery{AssetId: 7, IncludeClosed: includeClosed}) {
+			require.NoError(t, row.Error)
+			rows = append(rows, row)
+		}
+		return
+	}
+	rows := balances(false)
+	require.Len(t, rows, 1)
+	assert.Equal(t, open, rows[0].Address)
+	assert.False(t, rows[0].Deleted)
+
+	rows = balances(true)
+	require.Len(t, rows, 2)
+	assert.Equal(t, closed, rows[1].Address)
+	assert.True(t, rows[1].Deleted)
+	require.NotNil(t, rows[1].ClosedRound)
+	assert.Equal(t, uint64(4), *rows[1].ClosedRound)
+}
+
+func TestGetAccountsIncludeClosed(t *testing.T) {
+	db, closeDb := setupTestPostgres(t)
+	defer closeDb()
+	setAccountRound(t, db, 10)
+
+	closed := testAddr('x')
+	_, err := db.db.Exec(`INSERT INTO account (addr, microalgos, rewardsbase, account_data, created_at, closed_at, deleted) VALUES ($1, 0, 0, '{}', 2, 5, true)`, closed)
+	require.NoError(t, err)
+
+	accounts := func(includeClosed bool) (rows []AccountRow) {
+		for row := range db.GetAccounts(context.Background(), AccountQueryOptions{EqualToAddress: closed, IncludeClosed: includeClosed}) {
+			require.NoError(t, row.Error)
+			rows = append(rows, row)
+		}
+		return
+	}
+	assert.Empty(t, accounts(false))
+	rows := accounts(true)
+	require.Len(t, rows, 1)
+	require.NotNil(t, rows[0].Account.Deleted)
+	assert.True(t, *rows[0].Account.Deleted)
+	require.NotNil(t, rows[0].Account.ClosedAtRound)
+	assert.Equal(t, uint64(5), *rows[0].Account.ClosedAtRound)
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	db, closeDb := setupTestPostgres(t)
+	defer closeDb()
+
+	var creator, reserve types.Address
+	creator[0] = 1
+	reserve[0] = 2
+	var snapshot types.LedgerSnapshot
+	snapshot.Block.Round = 100
+	snapshot.Block.TimeStamp = 1600000000
+	snapshot.Block.RewardsLevel = 3
+	snapshot.Accounts = []types.GenesisAllocation{
+		{
+			Address: creator.String(),
+			State: types.AccountData{
+				MicroAlgos:  1000,
+				RewardsBase: 2,
+				AssetParams: map[types.AssetIndex]types.AssetParams{7: {Total: 50, UnitName: "gold", Reserve: reserve}},
+				Assets:      map[types.AssetIndex]types.AssetHolding{7: {Amount: 40, Frozen: true}},
+			},
+		},
+	}
+	require.NoError(t, db.LoadSnapshot(snapshot))
+
+	var microalgos, rewardsbase uint64
+	var createdAt sql.NullInt64
+	var deleted bool
+	err := db.db.QueryRow(`SELECT microalgos, rewardsbase, created_at, deleted FROM account WHERE addr = $1`, creator[:]).Scan(&microalgos, &rewardsbase, &createdAt, &deleted)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), microalgos)
+	assert.Equal(t, uint64(2), rewardsbase)
+	assert.False(t, createdAt.Valid)
+	assert.False(t, deleted)
+
+	var paramsjson []byte
+	var reserveAddr []byte
+	err = db.db.QueryRow(`SELECT params, reserve_addr, created_at FROM asset WHERE index = 7 AND creator_addr = $1`, creator[:]).Scan(&paramsjson, &reserveAddr, &createdAt)
+	require.NoError(t, err)
+	var params types.AssetParams
+	require.NoError(t, json.Decode(paramsjson, &params))
+	assert.Equal(t, uint64(50), params.Total)
+	assert.Equal(t, "gold", params.UnitName)
+	assert.Equal(t, reserve[:], reserveAddr)
+	assert.False(t, createdAt.Valid)
+
+	var amount uint64
+	var frozen bool
+	err = db.db.QueryRow(`SELECT amount, frozen FROM account_asset WHERE addr = $1 AND assetid = 7`, creator[:]).Scan(&amount, &frozen)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(40), amount)
+	assert.True(t, frozen)
+
+	stateJsonStr, err := db.GetMetastate("state")
+	require.NoError(t, err)
+	state, err := ParseImportState(stateJsonStr)
+	require.NoError(t, err)
+	assert.Equal(t, ImportState{AccountRound: 100}, state)
+
+	retentionJsonStr, err := db.GetMetastate("retention")
+	require.NoError(t, err)
+	retention, err := ParseRetentionState(retentionJsonStr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(101), retention.EarliestRound)
+
+	// pruning never moves the earliest round back before the snapshot
+	_, err = db.db.Exec(`INSERT INTO metastate (k, v) VALUES ('state', '{"account_round": 200}') ON CONFLICT (k) DO UPDATE SET v = EXCLUDED.v`)
+	require.NoError(t, err)
+	earliest, err := db.PruneTransactions(RetentionPolicy{Rounds: 150})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(101), earliest)
+}
+
+func TestStatsBackfillKeepsEndRound(t *testing.T) {
+	db, closeDb := setupTestPostgres(t)
+	defer closeDb()
+	setAccountRound(t, db, 10)
+
+	var stxn types.SignedTxnWithAD
+	stxn.Txn.Type = "pay"
+	stxn.Txn.Fee = 1000
+	txnbytes := msgpack.Encode(stxn)
+	for round := uint64(1); round <= 3; round++ {
+		_, err := db.db.Exec(`INSERT INTO block_header (round, realtime, rewardslevel, header) VALUES ($1, $2, 0, '{}')`, round, time.Unix(int64(round)*60, 0).UTC())
+		require.NoError(t, err)
+		_, err = db.db.Exec(`INSERT INTO txn (round, intra, typeenum, asset, txid, txnbytes) VALUES ($1, 0, 1, 0, $2, $3)`, round, []byte("txid"), txnbytes)
+		require.NoError(t, err)
+	}
+
+	// resumed after deciding to stop before round 3, though accounting is now at round 10
+	state := MigrationState{NextMigration: 14, EndRound: 3}
+	require.NoError(t, m14statsBackfill(db, &state))
+
+	var rounds []uint64
+	for row := range db.Stats(context.Background(), StatsQuery{Interval: StatsIntervalRound}) {
+		require.NoError(t, row.Error)
+		rounds = append(rounds, row.FirstRound)
+	}
+	assert.Equal(t, []uint64{2, 1}, rounds)
+}
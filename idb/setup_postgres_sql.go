@@ -26,10 +26,29 @@ typeenum smallint NOT NULL,
 asset bigint NOT NULL, -- 0=Algos, otherwise AssetIndex
 txid bytea NOT NULL, -- [32]byte
 txnbytes bytea NOT NULL,
-txn jsonb NOT NULL,
+txn jsonb, -- NULL when only storing msgpack txnbytes, see metastate txn_storage
 extra jsonb,
+amount bigint, -- pay Algos, NULL for other types
+closeamount bigint, -- Algos swept to CloseRemainderTo
+assetamount numeric(20), -- axfer amount, NULL for other types
+note bytea,
+sigtype varchar(8), -- sig,msig,lsig
+rekeyto bytea,
+txgroup bytea, -- [32]byte group id of atomic transfer
+lsighash bytea, -- [32]byte program hash of a logic sig, which is also the address of an escrow
 PRIMARY KEY ( round, intra )
 );
+-- columns extracted from txnbytes for searching, added to older databases and filled in by migration
+ALTER TABLE txn ALTER COLUMN txn DROP NOT NULL;
+ALTER TABLE txn ADD COLUMN IF NOT EXISTS amount bigint;
+ALTER TABLE txn ADD COLUMN IF NOT EXISTS closeamount bigint;
+ALTER TABLE txn ADD COLUMN IF NOT EXISTS assetamount numeric(20);
+ALTER TABLE txn ADD COLUMN IF NOT EXISTS note bytea;
+ALTER TABLE txn ADD COLUMN IF NOT EXISTS sigtype varchar(8);
+ALTER TABLE txn ADD COLUMN IF NOT EXISTS rekeyto bytea;
+ALTER TABLE txn ADD COLUMN IF NOT EXISTS txgroup bytea;
+ALTER TABLE txn ADD COLUMN IF NOT EXISTS lsighash bytea;
+-- indexes on the above are created by migration m1txnSearchIndexes in postgres_migrations.go
 
 -- NOT a unique index because we don't guarantee txid is unique outside of its 1000 rounds.
 CREATE INDEX IF NOT EXISTS txn_by_tixid ON txn ( txid );
@@ -37,10 +56,32 @@ CREATE INDEX IF NOT EXISTS txn_by_tixid ON txn ( txid );
 CREATE TABLE IF NOT EXISTS txn_participation (
 addr bytea NOT NULL,
 round bigint NOT NULL,
-intra smallint NOT NULL
+intra smallint NOT NULL,
+role integer -- bitfield of AddressRole* in idb, how addr appears in the txn
 );
+ALTER TABLE txn_participation ADD COLUMN IF NOT EXISTS role integer;
 CREATE UNIQUE INDEX IF NOT EXISTS txn_participation_i ON txn_participation ( addr, round DESC, intra DESC );
 
+-- public keys of the multisig that signed a txn, directly or through a logic sig
+CREATE TABLE IF NOT EXISTS txn_msig (
+pubkey bytea NOT NULL, -- [32]byte ed25519 public key, the same bytes as its single sig address
+round bigint NOT NULL,
+intra smallint NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS txn_msig_i ON txn_msig ( pubkey, round DESC, intra DESC );
+CREATE INDEX IF NOT EXISTS txn_msig_round ON txn_msig ( round );
+
+-- multisig addresses that have signed a txn, one row per public key
+CREATE TABLE IF NOT EXISTS msig_account (
+addr bytea NOT NULL, -- multisig address
+idx smallint NOT NULL, -- position of pubkey in the multisig
+pubkey bytea NOT NULL,
+version smallint NOT NULL,
+threshold smallint NOT NULL,
+PRIMARY KEY ( addr, idx )
+);
+CREATE INDEX IF NOT EXISTS msig_account_pubkey ON msig_account ( pubkey );
+
 -- bookeeping for local file import
 CREATE TABLE IF NOT EXISTS imported (path text);
 
@@ -50,7 +91,55 @@ CREATE TABLE IF NOT EXISTS account (
   microalgos bigint NOT NULL, -- okay because less than 2^54 Algos
   rewardsbase bigint NOT NULL,
   keytype varchar(8), -- sig,msig,lsig
-  account_data jsonb -- data.basics.AccountData except AssetParams and Assets and MicroAlgos and RewardsBase
+  account_data jsonb, -- data.basics.AccountData except AssetParams and Assets and MicroAlgos and RewardsBase
+  lsigprogram bytea, -- logic of an escrow (keytype lsig) account
+  created_at bigint, -- round the account was (re)opened, NULL if unknown
+  closed_at bigint, -- round the account was last closed
+  last_active bigint, -- round the account last sent a txn
+  deleted boolean NOT NULL DEFAULT false -- closed and not reopened
+);
+ALTER TABLE account ADD COLUMN IF NOT EXISTS lsigprogram bytea;
+ALTER TABLE account ADD COLUMN IF NOT EXISTS created_at bigint;
+ALTER TABLE account ADD COLUMN IF NOT EXISTS closed_at bigint;
+ALTER TABLE account ADD COLUMN IF NOT EXISTS last_active bigint;
+ALTER TABLE account ADD COLUMN IF NOT EXISTS deleted boolean NOT NULL DEFAULT false;
+-- GetAccounts OrderByBalance
+CREATE INDEX IF NOT EXISTS account_by_microalgos ON account ( microalgos, addr );
+-- GetAccounts VoteLastBefore, must match the expression there
+CREATE INDEX IF NOT EXISTS account_by_votelst ON account ( ((account_data ->> 'voteLst')::bigint) ) WHERE account_data ->> 'onl' = '1';
+-- GetAccounts ActiveSince
+CREATE INDEX IF NOT EXISTS account_by_last_active ON account ( last_active );
+
+-- History tables (account_auth, account_keyreg, asset_config) have a row for each txn that changed
+-- something, keyed by what it changed and the txn round and intra. Accounting writes them and
+-- they are kept when transactions are pruned.
+
+-- every rekey of an account
+CREATE TABLE IF NOT EXISTS account_auth (
+  addr bytea NOT NULL,
+  round bigint NOT NULL,
+  intra smallint NOT NULL,
+  txid bytea NOT NULL,
+  oldauth bytea, -- NULL when addr was authorized by its own key
+  newauth bytea, -- NULL when rekeyed back to its own key
+  PRIMARY KEY (addr, round, intra)
+);
+-- GetAccounts EverAuthAddr
+CREATE INDEX IF NOT EXISTS account_auth_newauth ON account_auth ( newauth );
+
+-- every keyreg of an account, a history table like account_auth
+CREATE TABLE IF NOT EXISTS account_keyreg (
+  addr bytea NOT NULL,
+  round bigint NOT NULL,
+  intra smallint NOT NULL,
+  txid bytea NOT NULL,
+  status smallint NOT NULL, -- {Offline:0, Online:1, NotParticipating: 2}
+  votekey bytea, -- this and the rest are NULL unless Online
+  selkey bytea,
+  votefirst bigint,
+  votelast bigint,
+  votekd bigint,
+  PRIMARY KEY (addr, round, intra)
 );
 
 -- data.basics.AccountData Assets[asset id] AssetHolding{}
@@ -59,17 +148,105 @@ CREATE TABLE IF NOT EXISTS account_asset (
   assetid bigint NOT NULL,
   amount numeric(20) NOT NULL, -- need the full 18446744073709551615
   frozen boolean NOT NULL,
+  created_at bigint, -- round of the (re)opt-in, NULL if unknown
+  closed_at bigint, -- round of the last opt-out
+  deleted boolean NOT NULL DEFAULT false, -- opted out and not opted in again
   PRIMARY KEY (addr, assetid)
 );
+ALTER TABLE account_asset ADD COLUMN IF NOT EXISTS created_at bigint;
+ALTER TABLE account_asset ADD COLUMN IF NOT EXISTS closed_at bigint;
+ALTER TABLE account_asset ADD COLUMN IF NOT EXISTS deleted boolean NOT NULL DEFAULT false;
+-- account_asset_by_asset_amount_addr, for finding holders of an asset, GetAccounts HasAssetId and AssetBalances,
+-- is created by migration m15assetAmountAddrIndex in postgres_migrations.go
 
 -- data.basics.AccountData AssetParams[index] AssetParams{}
 CREATE TABLE IF NOT EXISTS asset (
   index bigint PRIMARY KEY,
   creator_addr bytea NOT NULL,
-  params jsonb NOT NULL -- data.basics.AssetParams -- TODO index some fields?
+  params jsonb NOT NULL, -- data.basics.AssetParams, the role addresses are also in their own columns
+  created_at bigint, -- round of the creating acfg, NULL if unknown
+  destroyed_at bigint, -- round of the destroying acfg
+  deleted boolean NOT NULL DEFAULT false -- destroyed, params are kept as they last were
+);
+ALTER TABLE asset ADD COLUMN IF NOT EXISTS created_at bigint;
+ALTER TABLE asset ADD COLUMN IF NOT EXISTS destroyed_at bigint;
+ALTER TABLE asset ADD COLUMN IF NOT EXISTS deleted boolean NOT NULL DEFAULT false;
+-- params roles for AssetsQuery, NULL when the role is disabled
+ALTER TABLE asset ADD COLUMN IF NOT EXISTS manager_addr bytea;
+ALTER TABLE asset ADD COLUMN IF NOT EXISTS reserve_addr bytea;
+ALTER TABLE asset ADD COLUMN IF NOT EXISTS freeze_addr bytea;
+ALTER TABLE asset ADD COLUMN IF NOT EXISTS clawback_addr bytea;
+CREATE INDEX IF NOT EXISTS asset_by_manager ON asset ( manager_addr );
+CREATE INDEX IF NOT EXISTS asset_by_reserve ON asset ( reserve_addr );
+CREATE INDEX IF NOT EXISTS asset_by_freeze ON asset ( freeze_addr );
+CREATE INDEX IF NOT EXISTS asset_by_clawback ON asset ( clawback_addr );
+-- AssetsQuery Name, Unit and Query trigram indexes are made by setupTrigramSearch when pg_trgm is available
+
+-- every acfg of an asset, a history table like account_auth
+CREATE TABLE IF NOT EXISTS asset_config (
+  assetid bigint NOT NULL,
+  round bigint NOT NULL,
+  intra smallint NOT NULL,
+  txid bytea NOT NULL,
+  sender bytea NOT NULL,
+  params jsonb, -- data.basics.AssetParams after the acfg, NULL when it destroyed the asset
+  PRIMARY KEY (assetid, round, intra)
 );
 -- TODO: index on creator_addr?
 
+-- txn rollups, written by accounting for each round and summed into each hour and day (UTC) the round is in
+-- sums are numeric as they can outgrow uint64, and are capped to it when read
+CREATE TABLE IF NOT EXISTS stats_round (
+  round bigint PRIMARY KEY,
+  realtime timestamp without time zone NOT NULL,
+  txns bigint NOT NULL,
+  pay_txns bigint NOT NULL,
+  keyreg_txns bigint NOT NULL,
+  acfg_txns bigint NOT NULL,
+  axfer_txns bigint NOT NULL,
+  afrz_txns bigint NOT NULL,
+  algo_volume numeric NOT NULL,
+  fees numeric NOT NULL,
+  new_accounts bigint NOT NULL,
+  active_accounts bigint NOT NULL -- distinct senders
+);
+CREATE INDEX IF NOT EXISTS stats_round_time ON stats_round ( realtime );
+CREATE TABLE IF NOT EXISTS stats_period (
+  period text NOT NULL, -- 'hour' or 'day'
+  start timestamp without time zone NOT NULL,
+  first_round bigint NOT NULL,
+  last_round bigint NOT NULL,
+  txns bigint NOT NULL,
+  pay_txns bigint NOT NULL,
+  keyreg_txns bigint NOT NULL,
+  acfg_txns bigint NOT NULL,
+  axfer_txns bigint NOT NULL,
+  afrz_txns bigint NOT NULL,
+  algo_volume numeric NOT NULL,
+  fees numeric NOT NULL,
+  new_accounts bigint NOT NULL,
+  active_accounts bigint NOT NULL, -- distinct senders, counted when last_active was before first_round
+  PRIMARY KEY (period, start)
+);
+CREATE TABLE IF NOT EXISTS stats_asset_round (
+  assetid bigint NOT NULL,
+  round bigint NOT NULL,
+  realtime timestamp without time zone NOT NULL,
+  transfers bigint NOT NULL,
+  volume numeric NOT NULL, -- units sent by axfers, not counting close outs
+  PRIMARY KEY (assetid, round)
+);
+CREATE TABLE IF NOT EXISTS stats_asset_period (
+  assetid bigint NOT NULL,
+  period text NOT NULL,
+  start timestamp without time zone NOT NULL,
+  first_round bigint NOT NULL,
+  last_round bigint NOT NULL,
+  transfers bigint NOT NULL,
+  volume numeric NOT NULL,
+  PRIMARY KEY (assetid, period, start)
+);
+
 -- subsumes ledger/accountdb.go accounttotals and acctrounds
 -- "state":{online, onlinerewardunits, offline, offlinerewardunits, notparticipating, notparticipatingrewardunits, rewardslevel, round bigint}
 CREATE TABLE IF NOT EXISTS metastate (
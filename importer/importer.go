@@ -111,6 +111,12 @@ func (imp *dbImporter) ImportDecodedBlock(blockContainer *types.EncodedBlockCert
 		participants = participate(participants, stxn.Txn.AssetSender[:])
 		participants = participate(participants, stxn.Txn.AssetReceiver[:])
 		participants = participate(participants, stxn.Txn.AssetCloseTo[:])
+		participants = participate(participants, stxn.Txn.FreezeAccount[:])
+		participants = participate(participants, stxn.Txn.AssetParams.Manager[:])
+		participants = participate(participants, stxn.Txn.AssetParams.Reserve[:])
+		participants = participate(participants, stxn.Txn.AssetParams.Freeze[:])
+		participants = participate(participants, stxn.Txn.AssetParams.Clawback[:])
+		participants = participate(participants, stxn.Txn.RekeyTo[:])
 		err = imp.db.AddTransaction(round, intra, txtypeenum, assetid, stxnad, participants)
 		if err != nil {
 			return txCount, fmt.Errorf("error importing txn r=%d i=%d, %v", round, intra, err)
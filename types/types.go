@@ -324,6 +324,25 @@ type (
 		State   AccountData `codec:"state"`
 	}
 
+	// LedgerSnapshot is not from go-algorand. It is the state of
+	// every account as of the round in Block, and is used to start
+	// indexing part way through the chain instead of replaying it
+	// from genesis.
+	LedgerSnapshot struct {
+		_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+		// Block is the header of the round the snapshot was
+		// taken at. Its protocol and rewards state are needed
+		// to continue accounting from the following round.
+		Block BlockHeader `codec:"block"`
+
+		// Accounts holds the state of every account after
+		// Block was applied, including asset holdings
+		// (State.Assets) and the params of assets each account
+		// created (State.AssetParams).
+		Accounts []GenesisAllocation `codec:"accounts"`
+	}
+
 	// from github.com/algorand/go-algorand/data/basics/userBalance.go
 
 	// AccountData contains the data associated with a given address.